@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/alecthomas/kingpin/v2"
@@ -20,6 +22,7 @@ var (
 	app    = kingpin.New("19box-admincli", "19box jukebox admin client")
 	server = app.Flag("server", "Server address").Default("http://localhost:8080").String()
 	token  = app.Flag("token", "Admin token (or set ADMIN_TOKEN env)").Envar("ADMIN_TOKEN").String()
+	sessID = app.Flag("session", "Session ID or room name (required when multiple sessions exist)").Short('s').String()
 
 	// status command
 	statusCmd = app.Command("status", "Get session status")
@@ -33,6 +36,16 @@ var (
 	// skip command
 	skipCmd = app.Command("skip", "Skip the current track")
 
+	// seek command
+	seekCmd      = app.Command("seek", "Move the current track to a position")
+	seekPosition = seekCmd.Arg("position", "Position from the start of the track (e.g. 1m30s)").Required().Duration()
+
+	// restart command
+	restartCmd = app.Command("restart", "Play the current track again from the beginning")
+
+	// previous command
+	previousCmd = app.Command("previous", "Play the previous track again").Alias("prev")
+
 	// kick command
 	kickCmd      = app.Command("kick", "Kick a listener")
 	kickListener = kickCmd.Arg("listener-id", "Listener ID (UUID)").Required().String()
@@ -42,6 +55,43 @@ var (
 
 	// stop command
 	stopCmd = app.Command("stop", "Stop the session")
+
+	// enqueue command
+	enqueueCmd      = app.Command("enqueue", "Force-enqueue a track, bypassing filters")
+	enqueueTrackID  = enqueueCmd.Arg("track-id", "Spotify track ID, URL or URI").Required().String()
+	enqueuePosition = enqueueCmd.Flag("position", "Queue position (1 = next, 0 = end of queue)").Default("0").Int32()
+	enqueueLabel    = enqueueCmd.Flag("label", "Requester display name").String()
+	enqueueBypass   = enqueueCmd.Flag("bypass", "Filter to bypass (repeatable, default: all filters)").Strings()
+
+	// stats command
+	statsCmd    = app.Command("stats", "Show request statistics")
+	statsTop    = statsCmd.Flag("top", "Number of most-rejected tracks to show (0 = all)").Default("10").Int32()
+	statsRecent = statsCmd.Flag("recent", "Number of recent requests to show").Default("0").Int32()
+
+	// create-session command
+	createCmd         = app.Command("create-session", "Create a new session (defaults come from the server config)").Alias("create")
+	createTitle       = createCmd.Flag("title", "Session title").String()
+	createStart       = createCmd.Flag("start", "Start time (RFC3339, default: when started)").String()
+	createEnd         = createCmd.Flag("end", "End time (RFC3339, default: manual stop only)").String()
+	createKeywords    = createCmd.Flag("keyword", "Session keyword (repeatable)").Strings()
+	createOpening     = createCmd.Flag("opening", "Opening playlist URL").String()
+	createOpeningName = createCmd.Flag("opening-name", "Opening playlist display name").String()
+	createEnding      = createCmd.Flag("ending", "Ending playlist URL").String()
+	createEndingName  = createCmd.Flag("ending-name", "Ending playlist display name").String()
+	createRoom        = createCmd.Flag("room", "Room name (required when rooms are configured)").String()
+
+	// start-session command
+	startCmd       = app.Command("start-session", "Start the created session").Alias("start")
+	startSessionID = startCmd.Arg("session-id", "Session ID or room name (default: --session)").String()
+
+	// list-sessions command
+	sessionsCmd = app.Command("list-sessions", "List the sessions of all rooms").Alias("sessions")
+
+	// schedule command
+	scheduleCmd      = app.Command("schedule", "Change the session schedule")
+	scheduleStart    = scheduleCmd.Flag("start", "New start time (RFC3339, only before the session starts)").String()
+	scheduleEnd      = scheduleCmd.Flag("end", "New end time (RFC3339)").String()
+	scheduleClearEnd = scheduleCmd.Flag("clear-end", "Remove the end time (manual stop only)").Bool()
 )
 
 func main() {
@@ -68,24 +118,61 @@ func main() {
 	// Execute command
 	switch command {
 	case statusCmd.FullCommand():
-		status(ctx, client, *token)
+		status(ctx, client, *token, *sessID)
 	case pauseCmd.FullCommand():
-		pause(ctx, client, *token)
+		pause(ctx, client, *token, *sessID)
 	case resumeCmd.FullCommand():
-		resume(ctx, client, *token)
+		resume(ctx, client, *token, *sessID)
 	case skipCmd.FullCommand():
-		skip(ctx, client, *token)
+		skip(ctx, client, *token, *sessID)
+	case seekCmd.FullCommand():
+		seek(ctx, client, *token, *sessID, *seekPosition)
+	case restartCmd.FullCommand():
+		restart(ctx, client, *token, *sessID)
+	case previousCmd.FullCommand():
+		previous(ctx, client, *token, *sessID)
 	case kickCmd.FullCommand():
-		kick(ctx, client, *token, *kickListener)
+		kick(ctx, client, *token, *sessID, *kickListener)
 	case listCmd.FullCommand():
-		listListeners(ctx, client, *token)
+		listListeners(ctx, client, *token, *sessID)
 	case stopCmd.FullCommand():
-		stopSession(ctx, client, *token)
+		stopSession(ctx, client, *token, *sessID)
+	case enqueueCmd.FullCommand():
+		forceEnqueue(ctx, client, *token, *sessID, *enqueueTrackID, *enqueuePosition, *enqueueLabel, *enqueueBypass)
+	case statsCmd.FullCommand():
+		requestStats(ctx, client, *token, *sessID, *statsTop, *statsRecent)
+	case createCmd.FullCommand():
+		req := &jukeboxv1.CreateSessionRequest{
+			Title:     *createTitle,
+			StartTime: *createStart,
+			EndTime:   *createEnd,
+			Keywords:  *createKeywords,
+			Room:      *createRoom,
+		}
+		if *createOpening != "" {
+			req.Opening = &jukeboxv1.SessionPlaylist{PlaylistUrl: *createOpening, DisplayName: *createOpeningName}
+		}
+		if *createEnding != "" {
+			req.Ending = &jukeboxv1.SessionPlaylist{PlaylistUrl: *createEnding, DisplayName: *createEndingName}
+		}
+		createSession(ctx, client, *token, req)
+	case startCmd.FullCommand():
+		id := *startSessionID
+		if id == "" {
+			id = *sessID
+		}
+		startSession(ctx, client, *token, id)
+	case sessionsCmd.FullCommand():
+		listSessions(ctx, client, *token)
+	case scheduleCmd.FullCommand():
+		updateSchedule(ctx, client, *token, *sessID, *scheduleStart, *scheduleEnd, *scheduleClearEnd)
 	}
 }
 
-func status(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
-	req := connect.NewRequest(&jukeboxv1.GetStatusRequest{})
+func status(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.GetStatusRequest{
+		SessionId: sessionID,
+	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.GetStatus(ctx, req)
 	if err != nil {
@@ -96,12 +183,21 @@ func status(ctx context.Context, client jukeboxv1connect.AdminServiceClient, tok
 	s := resp.Msg
 	fmt.Println("\n=== CURRENT SESSION STATUS ===")
 
+	if s.SessionInfo == nil {
+		fmt.Println("No session (server is idle)")
+		fmt.Println()
+		return
+	}
+
 	fmt.Printf("Queue Size: %d\n", s.QueueSize)
 	fmt.Printf("Listeners: %d\n", s.ListenerCount)
 
 	if s.SessionInfo != nil {
 		fmt.Println("\nSession Info:")
 		fmt.Printf("  Session ID: %s\n", s.SessionInfo.SessionId)
+		if s.SessionInfo.Room != "" {
+			fmt.Printf("  Room: %s\n", s.SessionInfo.Room)
+		}
 		fmt.Printf("  Playlist Name: %s\n", s.SessionInfo.PlaylistName)
 		fmt.Printf("  Playlist URL: %s\n", s.SessionInfo.PlaylistUrl)
 		fmt.Printf("  Keywords: %v\n", s.SessionInfo.Keywords)
@@ -111,6 +207,12 @@ func status(ctx context.Context, client jukeboxv1connect.AdminServiceClient, tok
 			fmt.Printf("  State: %s\n", formatSessionState(s.SessionInfo.State))
 		}
 		fmt.Printf("  Accepting Requests: %v\n", s.SessionInfo.AcceptingRequests)
+		if seg := s.SessionInfo.Segment; seg != nil {
+			fmt.Printf("  Segment: %s (since %s, requests paused: %v)\n", seg.Name, seg.StartTime, seg.RequestsPaused)
+		}
+		if s.SessionInfo.LastCall {
+			fmt.Printf("  Last Call: %d seconds left to request\n", s.SessionInfo.LastCallRemainingSeconds)
+		}
 	}
 
 	if s.CurrentTrack != nil {
@@ -131,11 +233,22 @@ func status(ctx context.Context, client jukeboxv1connect.AdminServiceClient, tok
 	} else {
 		fmt.Println("\nNo track currently playing")
 	}
+
+	if s.Drift != nil {
+		fmt.Println("\nPlayback Drift:")
+		fmt.Printf("  Drift: %+d ms (positive: server is ahead of the host)\n", s.Drift.DriftMs)
+		fmt.Printf("  Measured At: %s\n", s.Drift.MeasuredAt)
+		if s.Drift.Exceeded {
+			fmt.Println("  WARNING: drift exceeds the tolerance")
+		}
+	}
 	fmt.Println()
 }
 
-func pause(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
-	req := connect.NewRequest(&jukeboxv1.PauseRequest{})
+func pause(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.PauseRequest{
+		SessionId: sessionID,
+	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.Pause(ctx, req)
 	if err != nil {
@@ -150,8 +263,10 @@ func pause(ctx context.Context, client jukeboxv1connect.AdminServiceClient, toke
 	}
 }
 
-func resume(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
-	req := connect.NewRequest(&jukeboxv1.ResumeRequest{})
+func resume(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.ResumeRequest{
+		SessionId: sessionID,
+	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.Resume(ctx, req)
 	if err != nil {
@@ -166,8 +281,10 @@ func resume(ctx context.Context, client jukeboxv1connect.AdminServiceClient, tok
 	}
 }
 
-func skip(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
-	req := connect.NewRequest(&jukeboxv1.SkipRequest{})
+func skip(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.SkipRequest{
+		SessionId: sessionID,
+	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.Skip(ctx, req)
 	if err != nil {
@@ -182,9 +299,77 @@ func skip(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token
 	}
 }
 
-func kick(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, listenerID string) {
+func seek(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string, position time.Duration) {
+	req := connect.NewRequest(&jukeboxv1.SeekRequest{
+		SessionId:  sessionID,
+		PositionMs: position.Milliseconds(),
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.Seek(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !resp.Msg.Success {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+		return
+	}
+	fmt.Println("Track position changed")
+	printTrackPosition(resp.Msg.TrackInfo)
+}
+
+func restart(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.RestartRequest{
+		SessionId: sessionID,
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.Restart(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !resp.Msg.Success {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+		return
+	}
+	fmt.Println("Track restarted")
+	printTrackPosition(resp.Msg.TrackInfo)
+}
+
+func previous(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.PreviousRequest{
+		SessionId: sessionID,
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.Previous(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !resp.Msg.Success {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+		return
+	}
+	fmt.Println("Playing the previous track")
+	printTrackPosition(resp.Msg.TrackInfo)
+}
+
+// printTrackPosition prints the current track and its remaining time.
+func printTrackPosition(t *jukeboxv1.TrackInfo) {
+	if t == nil {
+		return
+	}
+	fmt.Printf("  Now: %s - %s\n", t.Name, strings.Join(t.Artists, ", "))
+	fmt.Printf("  Remaining: %d seconds\n", t.RemainingSeconds)
+}
+
+func kick(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID, listenerID string) {
 	req := connect.NewRequest(&jukeboxv1.KickRequest{
 		ListenerId: listenerID,
+		SessionId:  sessionID,
 	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.Kick(ctx, req)
@@ -200,8 +385,10 @@ func kick(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token
 	}
 }
 
-func listListeners(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
-	req := connect.NewRequest(&jukeboxv1.ListListenersRequest{})
+func listListeners(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.ListListenersRequest{
+		SessionId: sessionID,
+	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.ListListeners(ctx, req)
 	if err != nil {
@@ -220,8 +407,10 @@ func listListeners(ctx context.Context, client jukeboxv1connect.AdminServiceClie
 	}
 }
 
-func stopSession(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
-	req := connect.NewRequest(&jukeboxv1.StopSessionRequest{})
+func stopSession(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.StopSessionRequest{
+		SessionId: sessionID,
+	})
 	req.Header().Set(apiconnect.AdminTokenHeader, token)
 	resp, err := client.StopSession(ctx, req)
 	if err != nil {
@@ -236,6 +425,168 @@ func stopSession(ctx context.Context, client jukeboxv1connect.AdminServiceClient
 	}
 }
 
+func forceEnqueue(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID, trackID string, position int32, label string, bypass []string) {
+	req := connect.NewRequest(&jukeboxv1.ForceEnqueueRequest{
+		TrackId:        trackID,
+		Position:       position,
+		RequesterLabel: label,
+		BypassFilters:  bypass,
+		SessionId:      sessionID,
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.ForceEnqueue(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if resp.Msg.Success {
+		fmt.Printf("Track enqueued at position %d\n", resp.Msg.Position)
+	} else {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+	}
+}
+
+func createSession(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string, msg *jukeboxv1.CreateSessionRequest) {
+	req := connect.NewRequest(msg)
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.CreateSession(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if resp.Msg.Success {
+		fmt.Printf("Session created: %s\n", resp.Msg.SessionId)
+	} else {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+	}
+}
+
+func startSession(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string) {
+	req := connect.NewRequest(&jukeboxv1.StartSessionRequest{
+		SessionId: sessionID,
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.StartSession(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if resp.Msg.Success {
+		fmt.Println("Session started")
+	} else {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+	}
+}
+
+func listSessions(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token string) {
+	req := connect.NewRequest(&jukeboxv1.ListSessionsRequest{})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.ListSessions(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(resp.Msg.Sessions) == 0 {
+		fmt.Println("No sessions (server is idle)")
+		return
+	}
+
+	fmt.Printf("%-36s  %-12s  %-18s  %s\n", "SESSION ID", "ROOM", "STATE", "PLAYLIST")
+	for _, s := range resp.Msg.Sessions {
+		state := strings.TrimPrefix(s.State.String(), "SESSION_STATE_")
+		fmt.Printf("%-36s  %-12s  %-18s  %s\n", s.SessionId, s.Room, state, s.PlaylistName)
+	}
+}
+
+func updateSchedule(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID, start, end string, clearEnd bool) {
+	req := connect.NewRequest(&jukeboxv1.UpdateScheduleRequest{
+		SessionId:    sessionID,
+		StartTime:    start,
+		EndTime:      end,
+		ClearEndTime: clearEnd,
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.UpdateSchedule(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if resp.Msg.Success {
+		fmt.Println("Schedule updated")
+		if info := resp.Msg.SessionInfo; info != nil {
+			fmt.Printf("  Scheduled Start Time: %s\n", info.ScheduledStartTime)
+			fmt.Printf("  Scheduled End Time: %s\n", info.ScheduledEndTime)
+		}
+	} else {
+		fmt.Printf("Failed: %s\n", resp.Msg.Message)
+	}
+}
+
+func requestStats(ctx context.Context, client jukeboxv1connect.AdminServiceClient, token, sessionID string, top, recent int32) {
+	req := connect.NewRequest(&jukeboxv1.GetRequestStatsRequest{
+		TopTracks:   top,
+		RecentLimit: recent,
+		SessionId:   sessionID,
+	})
+	req.Header().Set(apiconnect.AdminTokenHeader, token)
+	resp, err := client.GetRequestStats(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	s := resp.Msg
+	fmt.Println("\n=== REQUEST STATISTICS ===")
+	fmt.Printf("Requests: %d (accepted: %d, rejected: %d)\n",
+		s.TotalRequests, s.AcceptedRequests, s.TotalRequests-s.AcceptedRequests)
+
+	fmt.Println("\nRejections by code:")
+	for _, c := range s.RejectionCounts {
+		fmt.Printf("  %-25s %d\n", c.Code, c.Count)
+	}
+
+	fmt.Println("\nListeners:")
+	for _, l := range s.Listeners {
+		fmt.Printf("  %s: %s (requests: %d, accepted: %d, rate: %.0f%%)\n",
+			l.ListenerId, l.DisplayName, l.Requests, l.Accepted, l.AcceptanceRate*100)
+	}
+
+	fmt.Println("\nMost rejected tracks:")
+	for _, t := range s.TopRejectedTracks {
+		codes := make([]string, len(t.Codes))
+		for i, c := range t.Codes {
+			codes[i] = fmt.Sprintf("%s=%d", c.Code, c.Count)
+		}
+		fmt.Printf("  %s: %s (rejections: %d) [%s]\n", t.TrackId, t.Name, t.Rejections, strings.Join(codes, ", "))
+	}
+
+	fmt.Println("\nFilters:")
+	for _, f := range s.Filters {
+		fmt.Printf("  %-30s evaluations: %d, rejections: %d, avg latency: %dus\n",
+			f.Filter, f.Evaluations, f.Rejections, f.AverageLatencyUs)
+	}
+
+	if len(s.RecentRequests) > 0 {
+		fmt.Println("\nRecent requests:")
+		for _, e := range s.RecentRequests {
+			result := "accepted"
+			if !e.Accepted {
+				result = "rejected (" + e.Code + ")"
+			}
+			if e.Forced {
+				result = "forced, " + result
+			}
+			fmt.Printf("  %s %s -> %s: %s\n", e.RequestedAt, e.DisplayName, e.TrackName, result)
+		}
+	}
+	fmt.Println()
+}
+
 func formatSessionState(state jukeboxv1.SessionState) string {
 	switch state {
 	case jukeboxv1.SessionState_SESSION_STATE_WAITING:
@@ -265,6 +616,8 @@ func formatTrackState(state jukeboxv1.TrackState) string {
 		return "▶️  Playing"
 	case jukeboxv1.TrackState_TRACK_STATE_PAUSED:
 		return "⏸  Paused"
+	case jukeboxv1.TrackState_TRACK_STATE_DROPPED:
+		return "🗑  Dropped"
 	default:
 		return "❓ Unknown"
 	}
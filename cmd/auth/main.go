@@ -12,6 +12,8 @@ import (
 	"github.com/alecthomas/kingpin/v2"
 	spotifyauth "github.com/zmb3/spotify/v2/auth"
 	"golang.org/x/oauth2"
+
+	"github.com/osa030/19box/internal/infra/spotify"
 )
 
 var (
@@ -37,11 +39,7 @@ func main() {
 		spotifyauth.WithRedirectURL(customRedirectURI),
 		spotifyauth.WithClientID(*clientID),
 		spotifyauth.WithClientSecret(*clientSecret),
-		spotifyauth.WithScopes(
-			spotifyauth.ScopePlaylistModifyPublic,
-			spotifyauth.ScopePlaylistModifyPrivate,
-			spotifyauth.ScopePlaylistReadPrivate,
-		),
+		spotifyauth.WithScopes(spotify.Scopes...),
 	)
 
 	// Start HTTP server for callback
@@ -24,6 +24,7 @@ import (
 	"github.com/osa030/19box/internal/app/session"
 	"github.com/osa030/19box/internal/gen/jukebox/v1/jukeboxv1connect"
 	"github.com/osa030/19box/internal/infra/config"
+	"github.com/osa030/19box/internal/infra/library"
 	"github.com/osa030/19box/internal/infra/logger"
 	"github.com/osa030/19box/internal/infra/spotify"
 )
@@ -117,15 +118,22 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("playlist validation failed: %w", err)
 	}
 
-	// Create session manager
-	sessionMgr, err := session.NewManager(cfg, spotifyClient)
-	if err != nil {
-		return fmt.Errorf("failed to create session manager: %w", err)
+	// Open the library of past sessions
+	var lib *library.Library
+	if cfg.Library.Path != "" {
+		lib, err = library.Open(cfg.Library.Path)
+		if err != nil {
+			return fmt.Errorf("failed to open library: %w", err)
+		}
+		zlog.Info().Msgf("library loaded: path=%s track_count=%d", cfg.Library.Path, lib.Len())
 	}
 
+	// Create session host
+	sessionHost := session.NewHost(cfg, spotifyClient, lib)
+
 	// Create RPC services
-	listenerService := apiconnect.NewListenerService(sessionMgr, cfg)
-	adminService := apiconnect.NewAdminService(sessionMgr, cfg)
+	listenerService := apiconnect.NewListenerService(sessionHost, cfg)
+	adminService := apiconnect.NewAdminService(sessionHost, cfg)
 
 	// Create HTTP mux
 	mux := http.NewServeMux()
@@ -155,12 +163,12 @@ func run(cfg *config.Config) error {
 	serverErrCh := make(chan error, 1)
 	serverStartedCh := make(chan struct{})
 
-	// Start session
-	go func() {
-		if err := sessionMgr.Start(ctx); err != nil {
-			zlog.Error().Msgf("Failed to start session: %v", err)
+	// Start the configured session of each room unless it is started from the admin API
+	for _, room := range roomNames(cfg) {
+		if err := startConfiguredSession(ctx, cfg, sessionHost, room); err != nil {
+			return err
 		}
-	}()
+	}
 
 	// Start server
 	go func() {
@@ -181,7 +189,8 @@ func run(cfg *config.Config) error {
 	executeHooks(cfg.Server.Hooks.OnStarted, "on_started")
 
 
-	// Wait for shutdown signal, session end, or server error
+	// Wait for shutdown signal or server error.
+	// The server stays up between sessions.
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
@@ -189,11 +198,9 @@ func run(cfg *config.Config) error {
 	case <-sigCh:
 		zlog.Info().Msg("Received shutdown signal...")
 		// Stop session immediately to send notifications (without ending playlist)
-		if err := sessionMgr.StopImmediate(ctx); err != nil {
+		if err := sessionHost.StopImmediate(ctx); err != nil {
 			zlog.Error().Msgf("Failed to stop session: %v", err)
 		}
-	case <-sessionMgr.Done():
-		zlog.Info().Msg("Session ended, shutting down...")
 	case err := <-serverErrCh:
 		return fmt.Errorf("server error: %w", err)
 	}
@@ -203,7 +210,14 @@ func run(cfg *config.Config) error {
 	defer cancel()
 
 	// Close session manager first to terminate active connections/streams
-	sessionMgr.Close()
+	sessionHost.Close()
+
+	// Write the plays still pending in the library
+	if lib != nil {
+		if err := lib.Close(); err != nil {
+			zlog.Error().Msgf("Failed to save library: %v", err)
+		}
+	}
 
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		zlog.Error().Msgf("Failed to shutdown server: %v", err)
@@ -217,6 +231,47 @@ func run(cfg *config.Config) error {
 	return nil
 }
 
+// roomNames returns the configured room names, or the single unnamed room if no rooms are configured.
+func roomNames(cfg *config.Config) []string {
+	if !cfg.HasRooms() {
+		return []string{""}
+	}
+	return cfg.RoomNames()
+}
+
+// startConfiguredSession creates and starts a room's session from its configuration,
+// or starts its recurrence schedule.
+func startConfiguredSession(ctx context.Context, cfg *config.Config, sessionHost *session.Host, room string) error {
+	roomCfg, err := cfg.ForRoom(room)
+	if err != nil {
+		return err
+	}
+	if roomCfg.Session.ManualStart {
+		zlog.Info().Msgf("Manual start enabled, waiting for a session to be created via the admin API: room=%s", room)
+		return nil
+	}
+	if roomCfg.Session.Recurrence != nil {
+		if err := sessionHost.StartRecurring(room); err != nil {
+			return fmt.Errorf("invalid recurrence (room %q): %w", room, err)
+		}
+		zlog.Info().Msgf("Recurring sessions enabled: room=%s cron=%q time_zone=%s", room, roomCfg.Session.Recurrence.Cron, roomCfg.Session.Recurrence.TimeZone)
+		return nil
+	}
+
+	params, err := session.ParamsFromConfig(cfg, room)
+	if err != nil {
+		return fmt.Errorf("invalid session config (room %q): %w", room, err)
+	}
+	m, err := sessionHost.CreateSession(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to create session (room %q): %w", room, err)
+	}
+	if _, err := sessionHost.StartSession(m.SessionID()); err != nil {
+		return fmt.Errorf("failed to start session (room %q): %w", room, err)
+	}
+	return nil
+}
+
 // printFilters prints available filters.
 func printFilters() {
 	fmt.Println("Available Filters:")
@@ -248,6 +303,20 @@ func validateFilterConfig(cfg *config.Config) error {
 		}
 	}
 
+	// Last call filters are only built from the registry, so unknown ones are errors too
+	for _, room := range roomNames(cfg) {
+		roomCfg, err := cfg.ForRoom(room)
+		if err != nil {
+			return err
+		}
+		if err := filter.ValidateConfigs(roomCfg.Session.LastCall.Filters); err != nil {
+			if room != "" {
+				return fmt.Errorf("room %s: session.last_call.filters: %w", room, err)
+			}
+			return fmt.Errorf("session.last_call.filters: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -309,6 +378,27 @@ func validatePlaylists(ctx context.Context, cfg *config.Config, spotifyClient *s
 		zlog.Info().Msg("Ending playlist not configured, session will end immediately after request acceptance stops")
 	}
 
+	// Validate playlists overridden by rooms
+	for _, room := range cfg.Rooms {
+		if room.Playlists == nil {
+			continue
+		}
+		for _, p := range []struct {
+			name string
+			url  string
+		}{
+			{"opening", room.Playlists.Opening.PlaylistURL},
+			{"ending", room.Playlists.Ending.PlaylistURL},
+		} {
+			if p.url == "" {
+				continue
+			}
+			if err := validate(room.Name+" "+p.name, p.url); err != nil {
+				errs = append(errs, fmt.Sprintf("%s playlist of room %s (%s): %v", p.name, room.Name, p.url, err))
+			}
+		}
+	}
+
 	if len(errs) > 0 {
 		return fmt.Errorf("playlist validation failed:\n  - %s", strings.Join(errs, "\n  - "))
 	}
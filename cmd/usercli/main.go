@@ -20,6 +20,7 @@ import (
 var (
 	app    = kingpin.New("19box-usercli", "19box jukebox user client for testing")
 	server = app.Flag("server", "Server address").Default("http://localhost:8080").String()
+	sessID = app.Flag("session", "Session ID or room name (required when multiple sessions exist)").Short('s').String()
 
 	// join command
 	joinCmd        = app.Command("join", "Join the session")
@@ -32,7 +33,8 @@ var (
 	requestTrackID  = requestCmd.Arg("track-id", "Spotify track ID").Required().String()
 
 	// subscribe command
-	subscribeCmd = app.Command("subscribe", "Subscribe to notifications")
+	subscribeCmd      = app.Command("subscribe", "Subscribe to notifications")
+	subscribeListener = subscribeCmd.Arg("listener-id", "Listener ID (UUID, optional: counts the listener as present while subscribed)").String()
 )
 
 func main() {
@@ -53,18 +55,19 @@ func main() {
 	// Execute command
 	switch command {
 	case joinCmd.FullCommand():
-		join(ctx, client, *joinName, *joinExternalID)
+		join(ctx, client, *sessID, *joinName, *joinExternalID)
 	case requestCmd.FullCommand():
-		requestTrack(ctx, client, *requestListener, *requestTrackID)
+		requestTrack(ctx, client, *sessID, *requestListener, *requestTrackID)
 	case subscribeCmd.FullCommand():
-		subscribe(ctx, client)
+		subscribe(ctx, client, *sessID, *subscribeListener)
 	}
 }
 
-func join(ctx context.Context, client jukeboxv1connect.ListenerServiceClient, displayName, externalID string) {
+func join(ctx context.Context, client jukeboxv1connect.ListenerServiceClient, sessionID, displayName, externalID string) {
 	resp, err := client.Join(ctx, connect.NewRequest(&jukeboxv1.JoinRequest{
 		DisplayName:    displayName,
 		ExternalUserId: externalID,
+		SessionId:      sessionID,
 	}))
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
@@ -74,10 +77,11 @@ func join(ctx context.Context, client jukeboxv1connect.ListenerServiceClient, di
 	fmt.Printf("Joined! Your listener ID: %s\n", resp.Msg.ListenerId)
 }
 
-func requestTrack(ctx context.Context, client jukeboxv1connect.ListenerServiceClient, listenerID, trackID string) {
+func requestTrack(ctx context.Context, client jukeboxv1connect.ListenerServiceClient, sessionID, listenerID, trackID string) {
 	resp, err := client.RequestTrack(ctx, connect.NewRequest(&jukeboxv1.RequestTrackRequest{
 		ListenerId: listenerID,
 		TrackId:    trackID,
+		SessionId:  sessionID,
 	}))
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
@@ -120,13 +124,18 @@ func formatTrackState(state jukeboxv1.TrackState) string {
 		return "▶️  Playing"
 	case jukeboxv1.TrackState_TRACK_STATE_PAUSED:
 		return "⏸  Paused"
+	case jukeboxv1.TrackState_TRACK_STATE_DROPPED:
+		return "🗑  Dropped"
 	default:
 		return "❓ Unknown"
 	}
 }
 
-func subscribe(ctx context.Context, client jukeboxv1connect.ListenerServiceClient) {
-	stream, err := client.SubscribeNotifications(ctx, connect.NewRequest(&jukeboxv1.SubscribeNotificationsRequest{}))
+func subscribe(ctx context.Context, client jukeboxv1connect.ListenerServiceClient, sessionID, listenerID string) {
+	stream, err := client.SubscribeNotifications(ctx, connect.NewRequest(&jukeboxv1.SubscribeNotificationsRequest{
+		SessionId:  sessionID,
+		ListenerId: listenerID,
+	}))
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
@@ -167,6 +176,16 @@ func printNotification(n *jukeboxv1.Notification) {
 		fmt.Println("=== STATE CHANGED ===")
 	case jukeboxv1.NotificationType_NOTIFICATION_TYPE_CHANGE_TRACK:
 		fmt.Println("=== TRACK CHANGED ===")
+	case jukeboxv1.NotificationType_NOTIFICATION_TYPE_SCHEDULE_UPDATED:
+		fmt.Println("=== SCHEDULE UPDATED ===")
+	case jukeboxv1.NotificationType_NOTIFICATION_TYPE_DRIFT_DETECTED:
+		fmt.Println("=== DRIFT DETECTED ===")
+	case jukeboxv1.NotificationType_NOTIFICATION_TYPE_SEGMENT_STARTED:
+		fmt.Println("=== SEGMENT STARTED ===")
+	case jukeboxv1.NotificationType_NOTIFICATION_TYPE_REQUEST_DROPPED:
+		fmt.Println("=== REQUEST DROPPED ===")
+	case jukeboxv1.NotificationType_NOTIFICATION_TYPE_LAST_CALL:
+		fmt.Println("=== LAST CALL ===")
 	default:
 		fmt.Printf("=== UNKNOWN EVENT (%v) ===\n", n.Type)
 	}
@@ -184,6 +203,12 @@ func printNotification(n *jukeboxv1.Notification) {
 			fmt.Printf("  State: %s\n", formatSessionState(n.SessionInfo.State))
 		}
 		fmt.Printf("  Accepting Requests: %v\n", n.SessionInfo.AcceptingRequests)
+		if seg := n.SessionInfo.Segment; seg != nil {
+			fmt.Printf("  Segment: %s (since %s, requests paused: %v)\n", seg.Name, seg.StartTime, seg.RequestsPaused)
+		}
+		if n.SessionInfo.LastCall {
+			fmt.Printf("  Last Call: %d seconds left to request\n", n.SessionInfo.LastCallRemainingSeconds)
+		}
 	}
 
 	// Print TrackInfo if available
@@ -203,5 +228,12 @@ func printNotification(n *jukeboxv1.Notification) {
 			fmt.Printf("  Track State: %s\n", formatTrackState(n.TrackInfo.State))
 		}
 	}
+
+	// Print Drift if available
+	if n.Drift != nil {
+		fmt.Println("\nPlayback Drift:")
+		fmt.Printf("  Drift: %+d ms (positive: server is ahead of the host)\n", n.Drift.DriftMs)
+		fmt.Printf("  Measured At: %s\n", n.Drift.MeasuredAt)
+	}
 	fmt.Println()
 }
@@ -3,6 +3,9 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
@@ -15,15 +18,15 @@ import (
 
 // AdminService implements the AdminService RPC.
 type AdminService struct {
-	session *session.Manager
-	config  *config.Config
+	host   *session.Host
+	config *config.Config
 }
 
 // NewAdminService creates a new AdminService.
-func NewAdminService(session *session.Manager, cfg *config.Config) *AdminService {
+func NewAdminService(host *session.Host, cfg *config.Config) *AdminService {
 	return &AdminService{
-		session: session,
-		config:  cfg,
+		host:   host,
+		config: cfg,
 	}
 }
 
@@ -35,13 +38,22 @@ func (s *AdminService) GetStatus(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.GetStatusRequest],
 ) (*connect.Response[jukeboxv1.GetStatusResponse], error) {
-	status := s.session.GetStatus()
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if errors.Is(err, session.ErrNoSession) {
+		// No session yet: the server is idle
+		return connect.NewResponse(&jukeboxv1.GetStatusResponse{}), nil
+	}
+	if err != nil {
+		return nil, sessionLookupError(err)
+	}
+	status := sess.GetStatus()
 
 	resp := &jukeboxv1.GetStatusResponse{
 		QueueSize:     int32(status.QueueSize),
 		ListenerCount: int32(status.ListenerCount),
 		SessionInfo:   status.SessionInfo,
 		CurrentTrack:  status.TrackInfo,
+		Drift:         status.Drift,
 	}
 
 	return connect.NewResponse(resp), nil
@@ -52,7 +64,10 @@ func (s *AdminService) Pause(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.PauseRequest],
 ) (*connect.Response[jukeboxv1.PauseResponse], error) {
-	err := s.session.Pause()
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Pause()
+	}
 	if err != nil {
 		return connect.NewResponse(&jukeboxv1.PauseResponse{
 			Success: false,
@@ -71,7 +86,10 @@ func (s *AdminService) Resume(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.ResumeRequest],
 ) (*connect.Response[jukeboxv1.ResumeResponse], error) {
-	err := s.session.Resume()
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Resume()
+	}
 	if err != nil {
 		return connect.NewResponse(&jukeboxv1.ResumeResponse{
 			Success: false,
@@ -90,7 +108,10 @@ func (s *AdminService) Skip(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.SkipRequest],
 ) (*connect.Response[jukeboxv1.SkipResponse], error) {
-	err := s.session.Skip()
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Skip()
+	}
 	if err != nil {
 		return connect.NewResponse(&jukeboxv1.SkipResponse{
 			Success: false,
@@ -104,12 +125,84 @@ func (s *AdminService) Skip(
 	}), nil
 }
 
+// Seek changes the playback position of the current track.
+func (s *AdminService) Seek(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.SeekRequest],
+) (*connect.Response[jukeboxv1.SeekResponse], error) {
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Seek(time.Duration(req.Msg.PositionMs) * time.Millisecond)
+	}
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.SeekResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.SeekResponse{
+		Success:   true,
+		Message:   "Track position changed",
+		TrackInfo: sess.GetStatus().TrackInfo,
+	}), nil
+}
+
+// Restart plays the current track again from the beginning.
+func (s *AdminService) Restart(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.RestartRequest],
+) (*connect.Response[jukeboxv1.RestartResponse], error) {
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Restart()
+	}
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.RestartResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.RestartResponse{
+		Success:   true,
+		Message:   "Track restarted",
+		TrackInfo: sess.GetStatus().TrackInfo,
+	}), nil
+}
+
+// Previous plays the previously played track again.
+func (s *AdminService) Previous(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.PreviousRequest],
+) (*connect.Response[jukeboxv1.PreviousResponse], error) {
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Previous(ctx)
+	}
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.PreviousResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.PreviousResponse{
+		Success:   true,
+		Message:   "Playing the previous track",
+		TrackInfo: sess.GetStatus().TrackInfo,
+	}), nil
+}
+
 // Kick kicks a listener.
 func (s *AdminService) Kick(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.KickRequest],
 ) (*connect.Response[jukeboxv1.KickResponse], error) {
-	err := s.session.KickListener(req.Msg.ListenerId)
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.KickListener(req.Msg.ListenerId)
+	}
 	if err != nil {
 		return connect.NewResponse(&jukeboxv1.KickResponse{
 			Success: false,
@@ -128,7 +221,14 @@ func (s *AdminService) ListListeners(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.ListListenersRequest],
 ) (*connect.Response[jukeboxv1.ListListenersResponse], error) {
-	listeners := s.session.ListListeners()
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if errors.Is(err, session.ErrNoSession) {
+		return connect.NewResponse(&jukeboxv1.ListListenersResponse{}), nil
+	}
+	if err != nil {
+		return nil, sessionLookupError(err)
+	}
+	listeners := sess.ListListeners()
 	infos := make([]*jukeboxv1.ListenerInfo, len(listeners))
 
 	for i, l := range listeners {
@@ -151,7 +251,10 @@ func (s *AdminService) StopSession(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.StopSessionRequest],
 ) (*connect.Response[jukeboxv1.StopSessionResponse], error) {
-	err := s.session.Stop(ctx)
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err == nil {
+		err = sess.Stop(ctx)
+	}
 	if err != nil {
 		return connect.NewResponse(&jukeboxv1.StopSessionResponse{
 			Success: false,
@@ -164,3 +267,300 @@ func (s *AdminService) StopSession(
 		Message: "Session stopped",
 	}), nil
 }
+
+// GetRequestStats returns request audit statistics.
+func (s *AdminService) GetRequestStats(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.GetRequestStatsRequest],
+) (*connect.Response[jukeboxv1.GetRequestStatsResponse], error) {
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if errors.Is(err, session.ErrNoSession) {
+		return connect.NewResponse(&jukeboxv1.GetRequestStatsResponse{}), nil
+	}
+	if err != nil {
+		return nil, sessionLookupError(err)
+	}
+	stats, entries := sess.GetRequestStats(int(req.Msg.TopTracks), int(req.Msg.RecentLimit))
+
+	resp := &jukeboxv1.GetRequestStatsResponse{
+		TotalRequests:    int32(stats.TotalRequests),
+		AcceptedRequests: int32(stats.AcceptedRequests),
+	}
+
+	for _, c := range stats.RejectionCounts {
+		resp.RejectionCounts = append(resp.RejectionCounts, &jukeboxv1.RejectionCount{
+			Code:  c.Code,
+			Count: int32(c.Count),
+		})
+	}
+
+	for _, l := range stats.Listeners {
+		resp.Listeners = append(resp.Listeners, &jukeboxv1.ListenerRequestStats{
+			ListenerId:     l.ListenerID,
+			DisplayName:    l.ListenerName,
+			Requests:       int32(l.Requests),
+			Accepted:       int32(l.Accepted),
+			AcceptanceRate: l.AcceptanceRate(),
+		})
+	}
+
+	for _, t := range stats.TopRejected {
+		codes := make([]*jukeboxv1.RejectionCount, 0, len(t.Codes))
+		for code, count := range t.Codes {
+			codes = append(codes, &jukeboxv1.RejectionCount{
+				Code:  code,
+				Count: int32(count),
+			})
+		}
+		sort.Slice(codes, func(i, j int) bool {
+			if codes[i].Count != codes[j].Count {
+				return codes[i].Count > codes[j].Count
+			}
+			return codes[i].Code < codes[j].Code
+		})
+		resp.TopRejectedTracks = append(resp.TopRejectedTracks, &jukeboxv1.TrackRejectionStats{
+			TrackId:    t.TrackID,
+			Name:       t.TrackName,
+			Rejections: int32(t.Rejections),
+			Codes:      codes,
+		})
+	}
+
+	for _, f := range stats.Filters {
+		resp.Filters = append(resp.Filters, &jukeboxv1.FilterEvaluationStats{
+			Filter:           f.Filter,
+			Evaluations:      int32(f.Evaluations),
+			Rejections:       int32(f.Rejections),
+			AverageLatencyUs: f.AverageLatency().Microseconds(),
+		})
+	}
+
+	for _, e := range entries {
+		evaluations := make([]*jukeboxv1.FilterEvaluation, len(e.Evaluations))
+		for i, ev := range e.Evaluations {
+			evaluations[i] = &jukeboxv1.FilterEvaluation{
+				Filter:    ev.Filter,
+				Accepted:  ev.Result.Accepted,
+				Code:      ev.Result.Code,
+				LatencyUs: ev.Latency.Microseconds(),
+			}
+		}
+		resp.RecentRequests = append(resp.RecentRequests, &jukeboxv1.RequestAuditEntry{
+			ListenerId:  e.ListenerID,
+			DisplayName: e.ListenerName,
+			TrackId:     e.TrackID,
+			TrackName:   e.TrackName,
+			Accepted:    e.Accepted,
+			Code:        e.Code,
+			RequestedAt: e.Timestamp.Format(time.RFC3339),
+			Evaluations: evaluations,
+			Forced:      e.Forced,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ForceEnqueue adds a track to the queue bypassing the filter chain.
+func (s *AdminService) ForceEnqueue(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.ForceEnqueueRequest],
+) (*connect.Response[jukeboxv1.ForceEnqueueResponse], error) {
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.ForceEnqueueResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	position, code, err := sess.ForceEnqueue(
+		ctx,
+		req.Msg.TrackId,
+		int(req.Msg.Position),
+		req.Msg.RequesterLabel,
+		req.Msg.BypassFilters,
+	)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.ForceEnqueueResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	if code != "" {
+		return connect.NewResponse(&jukeboxv1.ForceEnqueueResponse{
+			Success: false,
+			Message: s.config.GetMessage(code),
+			Code:    code,
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.ForceEnqueueResponse{
+		Success:  true,
+		Message:  "Track enqueued",
+		Position: int32(position),
+	}), nil
+}
+
+// CreateSession creates a new session in a room. Fields left empty fall back
+// to the room's configuration, except the start and end times.
+func (s *AdminService) CreateSession(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.CreateSessionRequest],
+) (*connect.Response[jukeboxv1.CreateSessionResponse], error) {
+	roomCfg, err := s.config.ForRoom(req.Msg.Room)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.CreateSessionResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	title := req.Msg.Title
+	if title == "" {
+		title = roomCfg.Session.Title
+	}
+	keywords := req.Msg.Keywords
+	if len(keywords) == 0 {
+		keywords = roomCfg.Session.Keywords
+	}
+
+	params, err := session.NewParams(
+		req.Msg.Room,
+		title,
+		req.Msg.StartTime,
+		req.Msg.EndTime,
+		keywords,
+		sessionPlaylist(req.Msg.Opening, roomCfg.Playlists.Opening),
+		sessionPlaylist(req.Msg.Ending, roomCfg.Playlists.Ending),
+	)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.CreateSessionResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+	params.Jingle = roomCfg.Playlists.Jingle
+
+	sess, err := s.host.CreateSession(ctx, params)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.CreateSessionResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.CreateSessionResponse{
+		Success:     true,
+		Message:     "Session created",
+		SessionId:   sess.SessionID(),
+		SessionInfo: sess.GetStatus().SessionInfo,
+	}), nil
+}
+
+// StartSession starts a created session.
+func (s *AdminService) StartSession(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.StartSessionRequest],
+) (*connect.Response[jukeboxv1.StartSessionResponse], error) {
+	sess, err := s.host.StartSession(req.Msg.SessionId)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.StartSessionResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.StartSessionResponse{
+		Success:     true,
+		Message:     "Session started",
+		SessionInfo: sess.GetStatus().SessionInfo,
+	}), nil
+}
+
+// ListSessions lists the sessions of all rooms.
+func (s *AdminService) ListSessions(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.ListSessionsRequest],
+) (*connect.Response[jukeboxv1.ListSessionsResponse], error) {
+	sessions := s.host.List()
+	infos := make([]*jukeboxv1.SessionInfo, len(sessions))
+	for i, sess := range sessions {
+		infos[i] = sess.GetStatus().SessionInfo
+	}
+
+	return connect.NewResponse(&jukeboxv1.ListSessionsResponse{
+		Sessions: infos,
+	}), nil
+}
+
+// sessionPlaylist resolves a requested playlist against its configured default.
+// An unset playlist uses the configured one; an empty display name uses the configured name.
+func sessionPlaylist(p *jukeboxv1.SessionPlaylist, def config.PlaylistEntryConfig) config.PlaylistEntryConfig {
+	if p == nil {
+		return def
+	}
+	entry := config.PlaylistEntryConfig{
+		PlaylistURL: p.PlaylistUrl,
+		DisplayName: p.DisplayName,
+	}
+	if entry.DisplayName == "" {
+		entry.DisplayName = def.DisplayName
+	}
+	return entry
+}
+
+// UpdateSchedule changes the start or end time of a session.
+func (s *AdminService) UpdateSchedule(
+	ctx context.Context,
+	req *connect.Request[jukeboxv1.UpdateScheduleRequest],
+) (*connect.Response[jukeboxv1.UpdateScheduleResponse], error) {
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.UpdateScheduleResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	startTime, err := parseOptionalTime(req.Msg.StartTime)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.UpdateScheduleResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+	endTime, err := parseOptionalTime(req.Msg.EndTime)
+	if err != nil {
+		return connect.NewResponse(&jukeboxv1.UpdateScheduleResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	if err := sess.UpdateSchedule(startTime, endTime, req.Msg.ClearEndTime); err != nil {
+		return connect.NewResponse(&jukeboxv1.UpdateScheduleResponse{
+			Success: false,
+			Message: err.Error(),
+		}), nil
+	}
+
+	return connect.NewResponse(&jukeboxv1.UpdateScheduleResponse{
+		Success:     true,
+		Message:     "Schedule updated",
+		SessionInfo: sess.GetStatus().SessionInfo,
+	}), nil
+}
+
+// parseOptionalTime parses an RFC3339 time. Returns nil if the value is empty.
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q (expected RFC3339): %w", value, err)
+	}
+	return &t, nil
+}
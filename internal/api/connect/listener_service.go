@@ -18,15 +18,15 @@ import (
 
 // ListenerService implements the ListenerService RPC.
 type ListenerService struct {
-	session *session.Manager
-	config  *config.Config
+	host   *session.Host
+	config *config.Config
 }
 
 // NewListenerService creates a new ListenerService.
-func NewListenerService(session *session.Manager, cfg *config.Config) *ListenerService {
+func NewListenerService(host *session.Host, cfg *config.Config) *ListenerService {
 	return &ListenerService{
-		session: session,
-		config:  cfg,
+		host:   host,
+		config: cfg,
 	}
 }
 
@@ -38,11 +38,19 @@ func (s *ListenerService) Join(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.JoinRequest],
 ) (*connect.Response[jukeboxv1.JoinResponse], error) {
-	listenerID, err := s.session.Join(req.Msg.DisplayName, req.Msg.ExternalUserId)
+	sess, err := s.host.Get(req.Msg.SessionId)
+	if err != nil {
+		return nil, sessionLookupError(err)
+	}
+
+	listenerID, err := sess.Join(req.Msg.DisplayName, req.Msg.ExternalUserId)
 	if err != nil {
 		if errors.Is(err, registry.ErrListenerKicked) {
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
+		if errors.Is(err, session.ErrSessionNotRunning) || errors.Is(err, session.ErrSessionNotStarted) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -56,7 +64,21 @@ func (s *ListenerService) RequestTrack(
 	ctx context.Context,
 	req *connect.Request[jukeboxv1.RequestTrackRequest],
 ) (*connect.Response[jukeboxv1.RequestTrackResponse], error) {
-	success, code, err := s.session.RequestTrack(ctx, req.Msg.ListenerId, req.Msg.TrackId)
+	sess, err := s.findSession(req.Msg.SessionId, req.Msg.ListenerId)
+	if err != nil {
+		// Requests are not accepted while no session exists
+		code := "invalid_listener"
+		if errors.Is(err, session.ErrNoSession) {
+			code = "acceptance_done"
+		}
+		return connect.NewResponse(&jukeboxv1.RequestTrackResponse{
+			Success: false,
+			Message: s.config.GetMessage(code),
+			Code:    code,
+		}), nil
+	}
+
+	success, code, err := sess.RequestTrack(ctx, req.Msg.ListenerId, req.Msg.TrackId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -80,16 +102,30 @@ func (s *ListenerService) SubscribeNotifications(
 	req *connect.Request[jukeboxv1.SubscribeNotificationsRequest],
 	stream *connect.ServerStream[jukeboxv1.Notification],
 ) error {
-	notifManager := s.session.GetNotificationManager()
+	sess, err := s.findSession(req.Msg.SessionId, req.Msg.ListenerId)
+	if err != nil {
+		return sessionLookupError(err)
+	}
+	notifManager := sess.GetNotificationManager()
+
+	if listenerID := req.Msg.ListenerId; listenerID != "" {
+		if err := sess.SubscribeListener(listenerID); err != nil {
+			if errors.Is(err, registry.ErrListenerKicked) {
+				return connect.NewError(connect.CodePermissionDenied, err)
+			}
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		defer sess.UnsubscribeListener(listenerID)
+	}
 
 	// 1. アダプターを用意し、購読を開始する
 	// INITIAL_STATE送信前に届いた通知をバッファリングするため、Flushが必要
 	adapter := &notificationStreamAdapter{stream: stream}
-	subscriptionID, sequenceNo := notifManager.Subscribe(adapter)
+	subscriptionID, sequenceNo := notifManager.Subscribe(adapter, req.Msg.ListenerId)
 	defer notifManager.Unsubscribe(subscriptionID)
 
 	// 2. 現在の状態を取得
-	status := s.session.GetStatus()
+	status := sess.GetStatus()
 
 	// 3. 初期状態をNotificationとして構築 (sequenceNo は Subscribe 時のものを利用)
 	initialNotification := &jukeboxv1.Notification{
@@ -113,12 +149,35 @@ func (s *ListenerService) SubscribeNotifications(
 	// Wait for context cancellation or session end
 	select {
 	case <-ctx.Done():
-	case <-s.session.Done():
+	case <-sess.Done():
 	}
 
 	return nil
 }
 
+// findSession returns the requested session, or the session the listener has
+// joined if no session ID is given.
+func (s *ListenerService) findSession(sessionID, listenerID string) (*session.Manager, error) {
+	if sessionID == "" {
+		if sess, err := s.host.FindByListener(listenerID); err == nil {
+			return sess, nil
+		}
+	}
+	return s.host.Get(sessionID)
+}
+
+// sessionLookupError converts a session lookup error to a connect error.
+func sessionLookupError(err error) error {
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, session.ErrSessionIDRequired):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+}
+
 // notificationStreamAdapter adapts connect.ServerStream to notification.Stream.
 type notificationStreamAdapter struct {
 	mu     sync.Mutex
@@ -0,0 +1,276 @@
+// Package audit provides the request audit log and rejection statistics.
+package audit
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/app/filter"
+)
+
+// Entry represents the audited outcome of a single track request.
+type Entry struct {
+	ListenerID   string
+	ListenerName string
+	TrackID      string
+	TrackName    string
+	Accepted     bool
+	Code         string              // Rejection code (empty when accepted)
+	Evaluations  []filter.Evaluation // Filter evaluations in chain order
+	Forced       bool                // Queued by an admin with ForceEnqueue
+	Timestamp    time.Time
+}
+
+// CodeCount represents the number of rejections for a code.
+type CodeCount struct {
+	Code  string
+	Count int
+}
+
+// ListenerStats represents request statistics for a listener.
+type ListenerStats struct {
+	ListenerID   string
+	ListenerName string
+	Requests     int
+	Accepted     int
+}
+
+// AcceptanceRate returns the ratio of accepted requests (0.0-1.0).
+func (s ListenerStats) AcceptanceRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Accepted) / float64(s.Requests)
+}
+
+// TrackStats represents rejection statistics for a track.
+type TrackStats struct {
+	TrackID    string
+	TrackName  string
+	Rejections int
+	Codes      map[string]int // Rejection count per code
+}
+
+// FilterStats represents evaluation statistics for a filter.
+type FilterStats struct {
+	Filter       string
+	Evaluations  int
+	Rejections   int
+	TotalLatency time.Duration
+}
+
+// AverageLatency returns the average Check latency of the filter.
+func (s FilterStats) AverageLatency() time.Duration {
+	if s.Evaluations == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(s.Evaluations)
+}
+
+// Stats is a snapshot of the aggregated request statistics.
+type Stats struct {
+	TotalRequests    int
+	AcceptedRequests int
+	RejectionCounts  []CodeCount     // Sorted by count (descending)
+	Listeners        []ListenerStats // Sorted by request count (descending)
+	TopRejected      []TrackStats    // Sorted by rejection count (descending)
+	Filters          []FilterStats   // In order of first evaluation
+}
+
+// Recorder records request audit entries and aggregates statistics.
+// Aggregates are kept for the whole session, while only the most recent
+// entries are retained in memory.
+type Recorder struct {
+	mu sync.RWMutex
+
+	maxEntries int
+	entries    []Entry
+
+	total       int
+	accepted    int
+	codeCounts  map[string]int
+	listeners   map[string]*ListenerStats
+	tracks      map[string]*TrackStats
+	filters     map[string]*FilterStats
+	filterOrder []string
+}
+
+// NewRecorder creates a new audit recorder.
+// maxEntries limits the number of retained entries (0 retains none).
+func NewRecorder(maxEntries int) *Recorder {
+	return &Recorder{
+		maxEntries: maxEntries,
+		entries:    make([]Entry, 0),
+		codeCounts: make(map[string]int),
+		listeners:  make(map[string]*ListenerStats),
+		tracks:     make(map[string]*TrackStats),
+		filters:    make(map[string]*FilterStats),
+	}
+}
+
+// Record records an entry, emits structured log events and updates statistics.
+func (r *Recorder) Record(e Entry) {
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+
+	for _, ev := range e.Evaluations {
+		zlog.Debug().
+			Str("listener_id", e.ListenerID).
+			Str("track_id", e.TrackID).
+			Str("filter", ev.Filter).
+			Bool("accepted", ev.Result.Accepted).
+			Str("code", ev.Result.Code).
+			Dur("latency", ev.Latency).
+			Msg("filter evaluation")
+	}
+	zlog.Info().
+		Str("listener_id", e.ListenerID).
+		Str("listener", e.ListenerName).
+		Str("track_id", e.TrackID).
+		Str("track", e.TrackName).
+		Bool("accepted", e.Accepted).
+		Bool("forced", e.Forced).
+		Str("code", e.Code).
+		Int("filters_evaluated", len(e.Evaluations)).
+		Msg("track request")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.maxEntries > 0 {
+		r.entries = append(r.entries, e)
+		if len(r.entries) > r.maxEntries {
+			r.entries = r.entries[len(r.entries)-r.maxEntries:]
+		}
+	}
+
+	r.total++
+	if e.Accepted {
+		r.accepted++
+	} else {
+		r.codeCounts[e.Code]++
+	}
+
+	if e.ListenerID != "" {
+		ls, ok := r.listeners[e.ListenerID]
+		if !ok {
+			ls = &ListenerStats{ListenerID: e.ListenerID}
+			r.listeners[e.ListenerID] = ls
+		}
+		if e.ListenerName != "" {
+			ls.ListenerName = e.ListenerName
+		}
+		ls.Requests++
+		if e.Accepted {
+			ls.Accepted++
+		}
+	}
+
+	if !e.Accepted && e.TrackID != "" {
+		ts, ok := r.tracks[e.TrackID]
+		if !ok {
+			ts = &TrackStats{TrackID: e.TrackID, Codes: make(map[string]int)}
+			r.tracks[e.TrackID] = ts
+		}
+		if e.TrackName != "" {
+			ts.TrackName = e.TrackName
+		}
+		ts.Rejections++
+		ts.Codes[e.Code]++
+	}
+
+	for _, ev := range e.Evaluations {
+		fs, ok := r.filters[ev.Filter]
+		if !ok {
+			fs = &FilterStats{Filter: ev.Filter}
+			r.filters[ev.Filter] = fs
+			r.filterOrder = append(r.filterOrder, ev.Filter)
+		}
+		fs.Evaluations++
+		fs.TotalLatency += ev.Latency
+		if !ev.Result.Accepted {
+			fs.Rejections++
+		}
+	}
+}
+
+// Entries returns a copy of the retained entries (oldest first).
+func (r *Recorder) Entries() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]Entry, len(r.entries))
+	copy(result, r.entries)
+	return result
+}
+
+// Stats returns a snapshot of the aggregated statistics.
+// topTracks limits the number of most-rejected tracks (0 means no limit).
+func (r *Recorder) Stats(topTracks int) Stats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stats := Stats{
+		TotalRequests:    r.total,
+		AcceptedRequests: r.accepted,
+		RejectionCounts:  make([]CodeCount, 0, len(r.codeCounts)),
+		Listeners:        make([]ListenerStats, 0, len(r.listeners)),
+		TopRejected:      make([]TrackStats, 0, len(r.tracks)),
+		Filters:          make([]FilterStats, 0, len(r.filterOrder)),
+	}
+
+	for code, count := range r.codeCounts {
+		stats.RejectionCounts = append(stats.RejectionCounts, CodeCount{Code: code, Count: count})
+	}
+	sort.Slice(stats.RejectionCounts, func(i, j int) bool {
+		a, b := stats.RejectionCounts[i], stats.RejectionCounts[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Code < b.Code
+	})
+
+	for _, ls := range r.listeners {
+		stats.Listeners = append(stats.Listeners, *ls)
+	}
+	sort.Slice(stats.Listeners, func(i, j int) bool {
+		a, b := stats.Listeners[i], stats.Listeners[j]
+		if a.Requests != b.Requests {
+			return a.Requests > b.Requests
+		}
+		return a.ListenerName < b.ListenerName
+	})
+
+	for _, ts := range r.tracks {
+		codes := make(map[string]int, len(ts.Codes))
+		for k, v := range ts.Codes {
+			codes[k] = v
+		}
+		stats.TopRejected = append(stats.TopRejected, TrackStats{
+			TrackID:    ts.TrackID,
+			TrackName:  ts.TrackName,
+			Rejections: ts.Rejections,
+			Codes:      codes,
+		})
+	}
+	sort.Slice(stats.TopRejected, func(i, j int) bool {
+		a, b := stats.TopRejected[i], stats.TopRejected[j]
+		if a.Rejections != b.Rejections {
+			return a.Rejections > b.Rejections
+		}
+		return a.TrackID < b.TrackID
+	})
+	if topTracks > 0 && len(stats.TopRejected) > topTracks {
+		stats.TopRejected = stats.TopRejected[:topTracks]
+	}
+
+	for _, name := range r.filterOrder {
+		stats.Filters = append(stats.Filters, *r.filters[name])
+	}
+
+	return stats
+}
@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/app/filter"
+)
+
+func TestRecorder_Stats(t *testing.T) {
+	r := NewRecorder(10)
+
+	r.Record(Entry{ListenerID: "l1", ListenerName: "Alice", TrackID: "t1", TrackName: "Song 1", Accepted: true,
+		Evaluations: []filter.Evaluation{
+			{Filter: "kicked_listener_filter", Result: filter.Accept(), Latency: 2 * time.Microsecond},
+			{Filter: "user_pending_filter", Result: filter.Accept(), Latency: 4 * time.Microsecond},
+		}})
+	r.Record(Entry{ListenerID: "l1", ListenerName: "Alice", TrackID: "t2", TrackName: "Song 2", Code: "user_pending",
+		Evaluations: []filter.Evaluation{
+			{Filter: "kicked_listener_filter", Result: filter.Accept(), Latency: 2 * time.Microsecond},
+			{Filter: "user_pending_filter", Result: filter.Reject("user_pending"), Latency: 6 * time.Microsecond},
+		}})
+	r.Record(Entry{ListenerID: "l2", ListenerName: "Bob", TrackID: "t2", TrackName: "Song 2", Code: "duplicate_track"})
+	r.Record(Entry{ListenerID: "l2", ListenerName: "Bob", TrackID: "t3", TrackName: "Song 3", Code: "duplicate_track"})
+
+	stats := r.Stats(0)
+
+	assert.Equal(t, 4, stats.TotalRequests)
+	assert.Equal(t, 1, stats.AcceptedRequests)
+
+	require.Len(t, stats.RejectionCounts, 2)
+	assert.Equal(t, CodeCount{Code: "duplicate_track", Count: 2}, stats.RejectionCounts[0])
+	assert.Equal(t, CodeCount{Code: "user_pending", Count: 1}, stats.RejectionCounts[1])
+
+	require.Len(t, stats.Listeners, 2)
+	assert.Equal(t, "Alice", stats.Listeners[0].ListenerName)
+	assert.InDelta(t, 0.5, stats.Listeners[0].AcceptanceRate(), 0.001)
+	assert.InDelta(t, 0.0, stats.Listeners[1].AcceptanceRate(), 0.001)
+
+	require.Len(t, stats.TopRejected, 2)
+	assert.Equal(t, "t2", stats.TopRejected[0].TrackID)
+	assert.Equal(t, 2, stats.TopRejected[0].Rejections)
+	assert.Equal(t, map[string]int{"user_pending": 1, "duplicate_track": 1}, stats.TopRejected[0].Codes)
+
+	require.Len(t, stats.Filters, 2)
+	assert.Equal(t, "kicked_listener_filter", stats.Filters[0].Filter)
+	assert.Equal(t, 2, stats.Filters[0].Evaluations)
+	assert.Equal(t, 0, stats.Filters[0].Rejections)
+	assert.Equal(t, "user_pending_filter", stats.Filters[1].Filter)
+	assert.Equal(t, 1, stats.Filters[1].Rejections)
+	assert.Equal(t, 5*time.Microsecond, stats.Filters[1].AverageLatency())
+}
+
+func TestRecorder_StatsTopTracksLimit(t *testing.T) {
+	r := NewRecorder(0)
+	for i := 0; i < 3; i++ {
+		r.Record(Entry{ListenerID: "l1", TrackID: "a", Code: "kicked"})
+	}
+	r.Record(Entry{ListenerID: "l1", TrackID: "b", Code: "kicked"})
+
+	stats := r.Stats(1)
+	require.Len(t, stats.TopRejected, 1)
+	assert.Equal(t, "a", stats.TopRejected[0].TrackID)
+	assert.Empty(t, r.Entries(), "entries should not be retained when maxEntries is 0")
+}
+
+func TestRecorder_EntriesRetention(t *testing.T) {
+	r := NewRecorder(2)
+	r.Record(Entry{TrackID: "1"})
+	r.Record(Entry{TrackID: "2"})
+	r.Record(Entry{TrackID: "3"})
+
+	entries := r.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "2", entries[0].TrackID)
+	assert.Equal(t, "3", entries[1].TrackID)
+	assert.False(t, entries[1].Timestamp.IsZero(), "timestamp should be set on record")
+
+	// Aggregates cover all entries even if they are no longer retained
+	assert.Equal(t, 3, r.Stats(0).TotalRequests)
+}
@@ -0,0 +1,149 @@
+package bgm
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+// arcTempoScale is the tempo difference (BPM) that counts as much as the full
+// energy or valence range when comparing a track with the arc.
+const arcTempoScale = 100.0
+
+// ArcTarget is the sound BGM aims for at a point of the session.
+// Nil targets are not followed.
+type ArcTarget struct {
+	Energy  *float64
+	Tempo   *float64
+	Valence *float64
+}
+
+// Arc is a curve of targets over the session, from its start (0.0) to its end (1.0).
+type Arc []config.ArcPointConfig
+
+// Target returns the targets at progress, interpolated linearly between the
+// points that set them. Before the first and after the last point, the nearest
+// point's value is used.
+func (a Arc) Target(progress float64) ArcTarget {
+	return ArcTarget{
+		Energy:  a.interpolate(progress, func(p config.ArcPointConfig) *float64 { return p.Energy }),
+		Tempo:   a.interpolate(progress, func(p config.ArcPointConfig) *float64 { return p.Tempo }),
+		Valence: a.interpolate(progress, func(p config.ArcPointConfig) *float64 { return p.Valence }),
+	}
+}
+
+// interpolate interpolates one attribute of the arc at progress.
+// Returns nil if no point sets the attribute.
+func (a Arc) interpolate(progress float64, value func(config.ArcPointConfig) *float64) *float64 {
+	var prev *config.ArcPointConfig
+	for i := range a {
+		p := &a[i]
+		v := value(*p)
+		if v == nil {
+			continue
+		}
+		if p.At >= progress {
+			if prev == nil || p.At == prev.At {
+				return v
+			}
+			pv := *value(*prev)
+			ratio := (progress - prev.At) / (p.At - prev.At)
+			result := pv + (*v-pv)*ratio
+			return &result
+		}
+		prev = p
+	}
+	if prev == nil {
+		return nil
+	}
+	return value(*prev)
+}
+
+// distance returns how far attributes are from the target (0: on target): the
+// mean difference over the attributes compared. Attributes the track does not
+// know are not compared, so tracks with fewer known attributes are not favoured.
+func (t ArcTarget) distance(a track.AudioAttributes) float64 {
+	d, compared := 0.0, 0
+	if t.Energy != nil {
+		d += math.Abs(a.Energy - *t.Energy)
+		compared++
+	}
+	if t.Tempo != nil && a.Tempo != nil {
+		d += math.Abs(*a.Tempo-*t.Tempo) / arcTempoScale
+		compared++
+	}
+	if t.Valence != nil && a.Valence != nil {
+		d += math.Abs(*a.Valence - *t.Valence)
+		compared++
+	}
+	if compared == 0 {
+		return 0
+	}
+	return d / float64(compared)
+}
+
+// Reranker orders BGM candidates by how close they are to the session arc.
+type Reranker struct {
+	source AttributeSource
+	arc    Arc
+}
+
+// NewReranker creates a re-ranker following the arc configured in cfg.
+// Returns nil if no arc is configured.
+func NewReranker(cfg config.BGMConfig, shared *SharedClients) (*Reranker, error) {
+	if len(cfg.Arc) == 0 {
+		return nil, nil
+	}
+	source, err := NewAttributeSource(cfg.Attributes, shared)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, nil
+	}
+	return &Reranker{source: source, arc: Arc(cfg.Arc)}, nil
+}
+
+// Rerank sets the audio attributes of the candidates and orders them by their
+// distance to the arc at progress (0.0: session start, 1.0: end time), closest
+// first. Candidates without attributes keep their order after the others.
+func (r *Reranker) Rerank(ctx context.Context, candidates []CandidateWithSource, progress float64) []CandidateWithSource {
+	if len(candidates) == 0 {
+		return candidates
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Track.Attributes == nil {
+			ids = append(ids, c.Track.ID)
+		}
+	}
+	if len(ids) > 0 {
+		attributes, err := r.source.AudioAttributes(ctx, ids)
+		if err != nil {
+			zlog.Warn().Msgf("failed to get audio attributes: %v", err)
+		}
+		for i := range candidates {
+			if a, ok := attributes[candidates[i].Track.ID]; ok {
+				candidates[i].Track.Attributes = &a
+			}
+		}
+	}
+
+	target := r.arc.Target(progress)
+	distance := func(c CandidateWithSource) float64 {
+		if c.Track.Attributes == nil {
+			return math.Inf(1)
+		}
+		return target.distance(*c.Track.Attributes)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return distance(candidates[i]) < distance(candidates[j])
+	})
+	return candidates
+}
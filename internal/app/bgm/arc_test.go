@@ -0,0 +1,278 @@
+package bgm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+func float(v float64) *float64 {
+	return &v
+}
+
+func TestArc_Target(t *testing.T) {
+	twoPoints := Arc{
+		{At: 0.2, Energy: float(0.3)},
+		{At: 0.8, Energy: float(0.9)},
+	}
+	threePoints := Arc{
+		{At: 0, Energy: float(0.2)},
+		{At: 0.5, Energy: float(0.8)},
+		{At: 1, Energy: float(0.4)},
+	}
+
+	tests := []struct {
+		name     string
+		arc      Arc
+		progress float64
+		want     *float64
+	}{
+		{name: "before the first point", arc: twoPoints, progress: 0.1, want: float(0.3)},
+		{name: "at the first point", arc: twoPoints, progress: 0.2, want: float(0.3)},
+		{name: "between two points", arc: twoPoints, progress: 0.5, want: float(0.6)},
+		{name: "at the last point", arc: twoPoints, progress: 0.8, want: float(0.9)},
+		{name: "after the last point", arc: twoPoints, progress: 1, want: float(0.9)},
+		{name: "at the start", arc: threePoints, progress: 0, want: float(0.2)},
+		{name: "at a point between segments", arc: threePoints, progress: 0.5, want: float(0.8)},
+		{name: "within the second segment", arc: threePoints, progress: 0.75, want: float(0.6)},
+		{name: "at the end", arc: threePoints, progress: 1, want: float(0.4)},
+		{
+			name: "points without the attribute are skipped",
+			arc: Arc{
+				{At: 0, Energy: float(0.2)},
+				{At: 0.5, Tempo: float(120)},
+				{At: 1, Energy: float(0.6)},
+			},
+			progress: 0.5,
+			want:     float(0.4),
+		},
+		{
+			name:     "no point sets the attribute",
+			arc:      Arc{{At: 0, Tempo: float(120)}},
+			progress: 0.5,
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.arc.Target(tt.progress).Energy
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.InDelta(t, *tt.want, *got, 1e-9)
+		})
+	}
+}
+
+func TestArc_Target_Attributes(t *testing.T) {
+	// Every attribute follows its own points
+	arc := Arc{
+		{At: 0, Energy: float(0.2), Tempo: float(100)},
+		{At: 0.5, Valence: float(0.4)},
+		{At: 1, Energy: float(0.6), Tempo: float(140), Valence: float(0.8)},
+	}
+
+	target := arc.Target(0.25)
+	require.NotNil(t, target.Energy)
+	require.NotNil(t, target.Tempo)
+	require.NotNil(t, target.Valence)
+	assert.InDelta(t, 0.3, *target.Energy, 1e-9)
+	assert.InDelta(t, 110, *target.Tempo, 1e-9)
+	assert.InDelta(t, 0.4, *target.Valence, 1e-9)
+}
+
+func TestArcTarget_Distance(t *testing.T) {
+	full := ArcTarget{Energy: float(0.5), Tempo: float(120), Valence: float(0.5)}
+
+	tests := []struct {
+		name       string
+		target     ArcTarget
+		attributes track.AudioAttributes
+		want       float64
+	}{
+		{
+			name:       "on target",
+			target:     full,
+			attributes: track.AudioAttributes{Energy: 0.5, Tempo: float(120), Valence: float(0.5)},
+			want:       0,
+		},
+		{
+			name:       "mean of every attribute",
+			target:     full,
+			attributes: track.AudioAttributes{Energy: 0.7, Tempo: float(140), Valence: float(0.4)},
+			want:       0.5 / 3,
+		},
+		{
+			name:       "unknown tempo and valence are skipped",
+			target:     full,
+			attributes: track.AudioAttributes{Energy: 0.7},
+			want:       0.2,
+		},
+		{
+			name:       "attributes without a target are skipped",
+			target:     ArcTarget{Energy: float(0.5)},
+			attributes: track.AudioAttributes{Energy: 0.7, Tempo: float(140), Valence: float(0.4)},
+			want:       0.2,
+		},
+		{
+			name:       "nothing to compare",
+			target:     ArcTarget{Tempo: float(120)},
+			attributes: track.AudioAttributes{Energy: 0.7},
+			want:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.target.distance(tt.attributes), 1e-9)
+		})
+	}
+}
+
+// fixedAttributes is an AttributeSource with fixed attributes.
+type fixedAttributes struct {
+	attributes map[string]track.AudioAttributes
+	err        error
+}
+
+func (s *fixedAttributes) AudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	result := make(map[string]track.AudioAttributes)
+	for _, id := range trackIDs {
+		if a, ok := s.attributes[id]; ok {
+			result[id] = a
+		}
+	}
+	return result, nil
+}
+
+func candidatesOf(tracks ...track.Track) []CandidateWithSource {
+	candidates := make([]CandidateWithSource, len(tracks))
+	for i, t := range tracks {
+		candidates[i] = CandidateWithSource{Track: t}
+	}
+	return candidates
+}
+
+func TestReranker_Rerank(t *testing.T) {
+	source := &fixedAttributes{attributes: map[string]track.AudioAttributes{
+		"low":     {Energy: 0.2},
+		"mid":     {Energy: 0.5},
+		"high":    {Energy: 0.9},
+		"tempo":   {Energy: 0.5, Tempo: float(150)},
+		"near":    {Energy: 0.6, Tempo: float(120)},
+		"unknown": {Energy: 0.5},
+		"partial": {Energy: 0.6},
+		"full":    {Energy: 0.7, Tempo: float(120), Valence: float(0.5)},
+	}}
+	rising := Arc{{At: 0, Energy: float(0.2)}, {At: 1, Energy: float(0.8)}}
+
+	tests := []struct {
+		name       string
+		source     *fixedAttributes
+		arc        Arc
+		candidates []track.Track
+		progress   float64
+		want       []string
+	}{
+		{
+			name:       "closest first at the start",
+			source:     source,
+			arc:        rising,
+			candidates: []track.Track{{ID: "high"}, {ID: "mid"}, {ID: "low"}},
+			progress:   0,
+			want:       []string{"low", "mid", "high"},
+		},
+		{
+			name:       "closest first at the end",
+			source:     source,
+			arc:        rising,
+			candidates: []track.Track{{ID: "low"}, {ID: "mid"}, {ID: "high"}},
+			progress:   1,
+			want:       []string{"high", "mid", "low"},
+		},
+		{
+			name:   "candidates without attributes keep their order last",
+			source: source,
+			arc:    Arc{{At: 0, Energy: float(0.5)}},
+			candidates: []track.Track{
+				{ID: "x"}, {ID: "high"}, {ID: "y"}, {ID: "mid"},
+			},
+			want: []string{"mid", "high", "x", "y"},
+		},
+		{
+			name:   "attributes already set are used",
+			source: source,
+			arc:    Arc{{At: 0, Energy: float(0.5)}},
+			candidates: []track.Track{
+				{ID: "high"}, {ID: "x", Attributes: &track.AudioAttributes{Energy: 0.6}},
+			},
+			want: []string{"x", "high"},
+		},
+		{
+			name:   "unknown tempo is not compared",
+			source: source,
+			arc:    Arc{{At: 0, Energy: float(0.5), Tempo: float(120)}},
+			candidates: []track.Track{
+				{ID: "tempo"}, {ID: "near"}, {ID: "unknown"},
+			},
+			want: []string{"unknown", "near", "tempo"},
+		},
+		{
+			// Summed, the partial track's single difference (0.1) would beat
+			// the full track's (0.2) although the full track is closer overall
+			name:       "partial tracks are not favoured over full ones",
+			source:     source,
+			arc:        Arc{{At: 0, Energy: float(0.5), Tempo: float(120), Valence: float(0.5)}},
+			candidates: []track.Track{{ID: "partial"}, {ID: "full"}},
+			want:       []string{"full", "partial"},
+		},
+		{
+			name:   "failed lookups leave the order",
+			source: &fixedAttributes{err: errNotFound},
+			arc:    Arc{{At: 0, Energy: float(0.5)}},
+			candidates: []track.Track{
+				{ID: "high"}, {ID: "mid"}, {ID: "x", Attributes: &track.AudioAttributes{Energy: 0.9}},
+			},
+			want: []string{"x", "high", "mid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reranker{source: tt.source, arc: tt.arc}
+			got := r.Rerank(context.Background(), candidatesOf(tt.candidates...), tt.progress)
+
+			ids := make([]string, len(got))
+			for i, c := range got {
+				ids[i] = c.Track.ID
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestNewReranker(t *testing.T) {
+	arc := []config.ArcPointConfig{{At: 0, Energy: float(0.5)}}
+
+	r, err := NewReranker(config.BGMConfig{Arc: arc}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, r, "no attribute source")
+
+	r, err = NewReranker(config.BGMConfig{Attributes: config.AttributesConfig{Source: "spotify"}}, NewSharedClients(&fakeSpotify{}, nil))
+	require.NoError(t, err)
+	assert.Nil(t, r, "no arc")
+
+	_, err = NewReranker(config.BGMConfig{Arc: arc, Attributes: config.AttributesConfig{Source: "unknown"}}, nil)
+	assert.Error(t, err)
+}
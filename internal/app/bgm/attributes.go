@@ -0,0 +1,65 @@
+package bgm
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/catalog"
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+// AttributeSource provides the audio attributes of tracks.
+type AttributeSource interface {
+	// AudioAttributes returns the attributes of the tracks, keyed by track ID.
+	// Tracks without known attributes are left out.
+	AudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error)
+}
+
+// NewAttributeSource creates the attribute source configured in cfg.
+// Returns nil if no source is configured.
+func NewAttributeSource(cfg config.AttributesConfig, shared *SharedClients) (AttributeSource, error) {
+	switch cfg.Source {
+	case "":
+		return nil, nil
+	case "spotify":
+		return shared, nil
+	case "file":
+		return newFileAttributeSource(cfg.Path)
+	default:
+		return nil, errors.Newf("unknown attribute source: %s", cfg.Source)
+	}
+}
+
+// fileAttributeSource provides audio attributes from a local catalog file,
+// standing in for an API. Tracks without an energy level have no attributes.
+type fileAttributeSource struct {
+	attributes map[string]track.AudioAttributes
+}
+
+func newFileAttributeSource(path string) (*fileAttributeSource, error) {
+	entries, err := catalog.Load(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load attributes")
+	}
+
+	s := &fileAttributeSource{attributes: make(map[string]track.AudioAttributes, len(entries))}
+	for _, e := range entries {
+		if a, ok := e.Attributes(); ok {
+			s.attributes[e.TrackID] = a
+		}
+	}
+	return s, nil
+}
+
+// AudioAttributes implements AttributeSource.
+func (s *fileAttributeSource) AudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error) {
+	result := make(map[string]track.AudioAttributes, len(trackIDs))
+	for _, id := range trackIDs {
+		if a, ok := s.attributes[id]; ok {
+			result[id] = a
+		}
+	}
+	return result, nil
+}
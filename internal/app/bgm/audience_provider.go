@@ -0,0 +1,325 @@
+package bgm
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/creasty/defaults"
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+type AudienceProviderConfig struct {
+	APIKey            string  `yaml:"api_key" mapstructure:"api_key"` // Last.fm API key for tags (tags are skipped without it)
+	ActiveMinutes     int     `yaml:"active_minutes" mapstructure:"active_minutes" default:"30" validate:"gte=0"`
+	TracksPerListener int     `yaml:"tracks_per_listener" mapstructure:"tracks_per_listener" default:"5" validate:"gte=1"`
+	ProfileSize       int     `yaml:"profile_size" mapstructure:"profile_size" default:"2" validate:"gte=1,lte=10"`
+	ArtistWeight      float64 `yaml:"artist_weight" mapstructure:"artist_weight" default:"0.5" validate:"gte=0,lte=1.0"`
+	TagWeight         float64 `yaml:"tag_weight" mapstructure:"tag_weight" default:"0.3" validate:"gte=0,lte=1.0"`
+	GenreWeight       float64 `yaml:"genre_weight" mapstructure:"genre_weight" default:"0.2" validate:"gte=0,lte=1.0"`
+}
+
+// AudienceProvider provides BGM tracks for the listeners who are in the session.
+// It builds a taste profile from each present listener's requests (artists,
+// Last.fm tags and Spotify genres) and takes turns between listeners, so that
+// BGM does not follow only the latest request.
+type AudienceProvider struct {
+	shared *SharedClients
+	lastfm LastFmClient // nil: tags are skipped
+
+	mu       sync.Mutex
+	audience AudienceSource
+	next     int // Listener served first by the next call
+
+	// Configuration
+	candidateCount int
+	config         *AudienceProviderConfig
+}
+
+// audienceTagCount is the number of Last.fm tags looked up per profile track.
+const audienceTagCount = 3
+
+// audienceSearchLimit is the number of tracks retrieved per tag or genre.
+const audienceSearchLimit = 10
+
+// NewAudienceProvider creates a new AudienceProvider.
+// The listeners are read from the source set with SetAudience.
+func NewAudienceProvider(shared *SharedClients, candidateCount int, settings map[string]any) (*AudienceProvider, error) {
+	if shared == nil || shared.Spotify() == nil {
+		return nil, errors.New("spotify client is required")
+	}
+
+	var config AudienceProviderConfig
+	if err := mapstructure.Decode(settings, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to decode settings")
+	}
+	if err := defaults.Set(&config); err != nil {
+		return nil, errors.Wrap(err, "failed to set defaults")
+	}
+	if err := validator.New().Struct(config); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+	if math.Abs(config.ArtistWeight+config.TagWeight+config.GenreWeight-1.0) > 1e-9 {
+		return nil, errors.New("artist weight, tag weight and genre weight must sum to 1.0")
+	}
+
+	p := &AudienceProvider{
+		shared:         shared,
+		candidateCount: candidateCount,
+		config:         &config,
+	}
+	if config.APIKey != "" {
+		lastfmClient, err := shared.LastFm(config.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		p.lastfm = lastfmClient
+	}
+	return p, nil
+}
+
+// SetAudience sets the source of the present listeners' requests.
+// Implements AudienceSetter.
+func (p *AudienceProvider) SetAudience(audience AudienceSource) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.audience = audience
+}
+
+// GetCandidates retrieves tracks matching the taste of the present listeners,
+// taking one track per listener in turn. Seed tracks are not used.
+// Returns no candidates while no present listener has requested a track.
+func (p *AudienceProvider) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, existingTrackIDs map[string]bool) ([]track.Track, error) {
+	if count <= 0 {
+		return []track.Track{}, nil
+	}
+
+	p.mu.Lock()
+	audience := p.audience
+	p.mu.Unlock()
+	if audience == nil {
+		return []track.Track{}, nil
+	}
+
+	requests := audience.AudienceTracks(time.Duration(p.config.ActiveMinutes) * time.Minute)
+	if len(requests) == 0 {
+		return []track.Track{}, nil
+	}
+
+	listenerIDs := make([]string, 0, len(requests))
+	for id := range requests {
+		listenerIDs = append(listenerIDs, id)
+	}
+	sort.Strings(listenerIDs)
+	zlog.Debug().Msgf("audience provider: listener_count=%d", len(listenerIDs))
+
+	// Each listener's own requests are never suggested back
+	exclude := make(map[string]bool, len(existingTrackIDs))
+	for id := range existingTrackIDs {
+		exclude[id] = true
+	}
+	for _, tracks := range requests {
+		for _, t := range tracks {
+			exclude[t.ID] = true
+		}
+	}
+
+	picks := make([][]track.Track, len(listenerIDs))
+	var wg sync.WaitGroup
+	for i, id := range listenerIDs {
+		tracks := requests[id]
+		if len(tracks) > p.config.TracksPerListener {
+			tracks = tracks[:p.config.TracksPerListener]
+		}
+		wg.Add(1)
+		go func(i int, tracks []track.Track) {
+			defer wg.Done()
+			picks[i] = p.candidatesFor(ctx, tracks, count, exclude)
+		}(i, tracks)
+	}
+	wg.Wait()
+
+	return p.interleave(picks, count), nil
+}
+
+// Name returns the provider name.
+func (p *AudienceProvider) Name() string {
+	return "audience"
+}
+
+// candidatesFor retrieves up to count tracks matching one listener's requests.
+// Tracks matching the listener's artists, tags and genres score the configured weights.
+func (p *AudienceProvider) candidatesFor(ctx context.Context, requested []track.Track, count int, exclude map[string]bool) []track.Track {
+	artistIDs := seedArtists(requested)
+	if len(artistIDs) > p.config.ProfileSize {
+		artistIDs = artistIDs[:p.config.ProfileSize]
+	}
+
+	var artistTracks, tagTracks, genreTracks []track.Track
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for _, id := range artistIDs {
+			artistTracks = append(artistTracks, p.shared.ArtistTopTracks(ctx, id)...)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		tagTracks = p.tagTracks(ctx, requested)
+	}()
+	go func() {
+		defer wg.Done()
+		genreTracks = p.genreTracks(ctx, requested)
+	}()
+	wg.Wait()
+
+	scoreMap := make(map[string]*ScoredTrack)
+	add := func(tracks []track.Track, weight float64) {
+		for _, t := range deduplicateByID(tracks) {
+			if exclude[t.ID] {
+				continue
+			}
+			if existing, ok := scoreMap[t.ID]; ok {
+				existing.Score += weight
+			} else {
+				scoreMap[t.ID] = &ScoredTrack{Track: t, Score: weight}
+			}
+		}
+	}
+	add(artistTracks, p.config.ArtistWeight)
+	add(tagTracks, p.config.TagWeight)
+	add(genreTracks, p.config.GenreWeight)
+
+	scored := make([]ScoredTrack, 0, len(scoreMap))
+	for _, s := range scoreMap {
+		scored = append(scored, *s)
+	}
+	return pickVaried(scored, count)
+}
+
+// tagTracks retrieves the Last.fm top tracks of the tags shared most by the requested tracks.
+func (p *AudienceProvider) tagTracks(ctx context.Context, requested []track.Track) []track.Track {
+	if p.lastfm == nil {
+		return nil
+	}
+
+	tagCounts := make(map[string]int)
+	for _, t := range requested {
+		if len(t.Artists) == 0 {
+			continue
+		}
+		tags, err := p.lastfm.GetTopTags(ctx, t.Name, t.Artists[0], audienceTagCount)
+		if err != nil {
+			zlog.Debug().Msgf("audience provider: tag lookup failed: track=%s error=%v", t.Name, err)
+			continue
+		}
+		for _, tag := range tags {
+			tagCounts[tag.Name]++
+		}
+	}
+
+	var tracks []track.Track
+	for _, tag := range topKeys(tagCounts, p.config.ProfileSize) {
+		lfmTracks, err := p.lastfm.GetTopTracks(ctx, tag, audienceSearchLimit)
+		if err != nil {
+			zlog.Debug().Msgf("audience provider: tag tracks failed: tag=%s error=%v", tag, err)
+			continue
+		}
+		for _, lfmTrack := range lfmTracks {
+			if t := p.shared.SearchTrack(ctx, lfmTrack.Name, lfmTrack.Artist); t != nil {
+				tracks = append(tracks, *t)
+			}
+		}
+	}
+	return tracks
+}
+
+// genreTracks searches Spotify for the genres shared most by the requested tracks' artists.
+func (p *AudienceProvider) genreTracks(ctx context.Context, requested []track.Track) []track.Track {
+	spotify := p.shared.Spotify()
+	artistIDs := seedArtists(requested)
+	if len(artistIDs) == 0 {
+		return nil
+	}
+
+	artists, err := spotify.GetArtists(ctx, artistIDs)
+	if err != nil {
+		zlog.Debug().Msgf("audience provider: artist lookup failed: %v", err)
+		return nil
+	}
+	genreCounts := make(map[string]int)
+	for _, a := range artists {
+		for _, genre := range a.Genres {
+			genreCounts[genre]++
+		}
+	}
+
+	var tracks []track.Track
+	for _, genre := range topKeys(genreCounts, p.config.ProfileSize) {
+		results, err := spotify.Search(ctx, fmt.Sprintf("genre:%q", genre), "track", audienceSearchLimit)
+		if err != nil {
+			zlog.Debug().Msgf("audience provider: genre search failed: genre=%s error=%v", genre, err)
+			continue
+		}
+		tracks = append(tracks, results...)
+	}
+	return tracks
+}
+
+// interleave takes one track per listener in turn until count tracks are taken.
+// The listener served first moves on with every call, so that with fewer
+// tracks than listeners everyone gets a turn.
+func (p *AudienceProvider) interleave(picks [][]track.Track, count int) []track.Track {
+	p.mu.Lock()
+	start := p.next
+	p.next++
+	p.mu.Unlock()
+
+	result := make([]track.Track, 0, count)
+	seen := make(map[string]bool)
+	for round := 0; len(result) < count; round++ {
+		added := false
+		for i := range picks {
+			tracks := picks[(start+i)%len(picks)]
+			if round >= len(tracks) {
+				continue
+			}
+			added = true
+			if t := tracks[round]; !seen[t.ID] && len(result) < count {
+				seen[t.ID] = true
+				result = append(result, t)
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return result
+}
+
+// topKeys returns the n keys with the highest counts, ties broken by key.
+func topKeys(counts map[string]int, n int) []string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
@@ -0,0 +1,210 @@
+package bgm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+	spotifyapi "github.com/osa030/19box/internal/infra/spotify"
+)
+
+// fixedAudience reports the same requests whatever the activity window.
+type fixedAudience map[string][]track.Track
+
+func (a fixedAudience) AudienceTracks(activeWithin time.Duration) map[string][]track.Track {
+	return a
+}
+
+func TestAudienceProvider_GetCandidates(t *testing.T) {
+	client := &fakeSpotify{
+		topTracks: map[string][]track.Track{
+			"art_a": tracksOf("req_a", "a1", "req_b"),
+			"art_b": tracksOf("b1"),
+		},
+		artists: map[string]spotifyapi.Artist{
+			"art_a": {ID: "art_a", Genres: []string{"jazz"}},
+		},
+		searches: map[string][]track.Track{
+			`genre:"jazz"`: tracksOf("g1", "g2", "a1"),
+		},
+	}
+	audience := fixedAudience{
+		"alice": {{ID: "req_a", ArtistIDs: []string{"art_a"}}},
+		"bob":   {{ID: "req_b", ArtistIDs: []string{"art_b"}}},
+	}
+
+	tests := []struct {
+		name     string
+		audience AudienceSource
+		existing map[string]bool
+		want     []string
+	}{
+		{
+			name:     "artists and genres of each listener",
+			audience: audience,
+			// The listeners' own requests are never suggested back
+			want: []string{"a1", "g1", "g2", "b1"},
+		},
+		{
+			name:     "existing tracks are excluded",
+			audience: audience,
+			existing: map[string]bool{"g1": true, "b1": true},
+			want:     []string{"a1", "g2"},
+		},
+		{
+			name:     "no present listeners",
+			audience: fixedAudience{},
+			want:     []string{},
+		},
+		{
+			name: "no audience",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewAudienceProvider(NewSharedClients(client, nil), 10, map[string]any{})
+			require.NoError(t, err)
+			if tt.audience != nil {
+				p.SetAudience(tt.audience)
+			}
+
+			got, err := p.GetCandidates(context.Background(), 10, nil, tt.existing)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, trackIDs(got))
+		})
+	}
+}
+
+func TestAudienceProvider_GetCandidates_Weights(t *testing.T) {
+	// a1 only matches the listener's artist, g1 only the genre, and x both
+	client := &fakeSpotify{
+		topTracks: map[string][]track.Track{"art_a": tracksOf("a1", "x")},
+		artists:   map[string]spotifyapi.Artist{"art_a": {ID: "art_a", Genres: []string{"jazz"}}},
+		searches:  map[string][]track.Track{`genre:"jazz"`: tracksOf("g1", "x")},
+	}
+	audience := fixedAudience{"alice": {{ID: "req_a", ArtistIDs: []string{"art_a"}}}}
+
+	tests := []struct {
+		name     string
+		settings map[string]any
+		lowest   string // Never among the best two, which one track is picked from
+	}{
+		{
+			name:     "artists weigh most",
+			settings: map[string]any{"artist_weight": 0.6, "tag_weight": 0.3, "genre_weight": 0.1},
+			lowest:   "g1",
+		},
+		{
+			name:     "genres weigh most",
+			settings: map[string]any{"artist_weight": 0.1, "tag_weight": 0.3, "genre_weight": 0.6},
+			lowest:   "a1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewAudienceProvider(NewSharedClients(client, nil), 10, tt.settings)
+			require.NoError(t, err)
+			p.SetAudience(audience)
+
+			picked := make(map[string]bool)
+			for range 50 {
+				got, err := p.GetCandidates(context.Background(), 1, nil, nil)
+				require.NoError(t, err)
+				require.Len(t, got, 1)
+				picked[got[0].ID] = true
+			}
+			assert.False(t, picked[tt.lowest], "the lowest scoring track is never picked")
+			assert.True(t, picked["x"], "the track matching both is picked")
+		})
+	}
+}
+
+func TestAudienceProvider_GetCandidates_Profile(t *testing.T) {
+	client := &fakeSpotify{topTracks: map[string][]track.Track{
+		"art_a": tracksOf("a1"),
+		"art_b": tracksOf("b1"),
+		"art_c": tracksOf("c1"),
+	}}
+
+	tests := []struct {
+		name     string
+		settings map[string]any
+		requests []track.Track
+		want     []string
+	}{
+		{
+			name:     "profile_size limits the artists",
+			settings: map[string]any{"profile_size": 2},
+			requests: []track.Track{
+				{ID: "req1", ArtistIDs: []string{"art_a"}},
+				{ID: "req2", ArtistIDs: []string{"art_b", "art_c"}},
+				{ID: "req3", ArtistIDs: []string{"art_c"}},
+			},
+			// Only the main artist of each request counts
+			want: []string{"a1", "b1"},
+		},
+		{
+			name:     "tracks_per_listener limits the requests",
+			settings: map[string]any{"tracks_per_listener": 1},
+			requests: []track.Track{
+				{ID: "req1", ArtistIDs: []string{"art_a"}},
+				{ID: "req2", ArtistIDs: []string{"art_c"}},
+			},
+			want: []string{"a1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewAudienceProvider(NewSharedClients(client, nil), 10, tt.settings)
+			require.NoError(t, err)
+			p.SetAudience(fixedAudience{"alice": tt.requests})
+
+			got, err := p.GetCandidates(context.Background(), 10, nil, nil)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, trackIDs(got))
+		})
+	}
+}
+
+func TestAudienceProvider_GetCandidates_TakesTurns(t *testing.T) {
+	client := &fakeSpotify{topTracks: map[string][]track.Track{
+		"art_a": tracksOf("a1"),
+		"art_b": tracksOf("b1"),
+	}}
+	p, err := NewAudienceProvider(NewSharedClients(client, nil), 10, map[string]any{})
+	require.NoError(t, err)
+	p.SetAudience(fixedAudience{
+		"alice": {{ID: "req_a", ArtistIDs: []string{"art_a"}}},
+		"bob":   {{ID: "req_b", ArtistIDs: []string{"art_b"}}},
+	})
+
+	// With one track per call, the listener served first changes every call
+	for _, want := range []string{"a1", "b1", "a1"} {
+		got, err := p.GetCandidates(context.Background(), 1, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{want}, trackIDs(got))
+	}
+
+	// With more tracks, one is taken per listener in turn
+	got, err := p.GetCandidates(context.Background(), 2, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b1", "a1"}, trackIDs(got))
+}
+
+func TestNewAudienceProvider_Validation(t *testing.T) {
+	shared := NewSharedClients(&fakeSpotify{}, nil)
+
+	_, err := NewAudienceProvider(shared, 10, map[string]any{"artist_weight": 0.5, "tag_weight": 0.5, "genre_weight": 0.5})
+	assert.Error(t, err, "weights must sum to 1")
+
+	_, err = NewAudienceProvider(shared, 10, map[string]any{"artist_weight": 0.6, "tag_weight": 0.1, "genre_weight": 0.3})
+	assert.NoError(t, err)
+}
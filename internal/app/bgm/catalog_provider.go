@@ -0,0 +1,271 @@
+package bgm
+
+import (
+	"context"
+	"math/rand/v2"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/creasty/defaults"
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/catalog"
+)
+
+type CatalogProviderConfig struct {
+	Path      string  `yaml:"path" mapstructure:"path" validate:"required"`
+	MinEnergy float64 `yaml:"min_energy" mapstructure:"min_energy" validate:"gte=0,lte=1.0"`
+	MaxEnergy float64 `yaml:"max_energy" mapstructure:"max_energy" default:"1.0" validate:"gte=0,lte=1.0,gtefield=MinEnergy"`
+	Ordered   bool    `yaml:"ordered" mapstructure:"ordered"` // Take tracks in catalog order instead of at random
+}
+
+// CatalogProvider provides BGM tracks from a local catalog file of curated
+// tracks (see package catalog). Tracks whose tags match the session keywords
+// and the tags of the seed tracks come first. The file is reloaded when it changes.
+type CatalogProvider struct {
+	shared *SharedClients
+
+	mu       sync.Mutex
+	entries  []catalog.Entry
+	modTime  time.Time
+	size     int64
+	keywords []string
+	resolved map[string]*track.Track // Spotify tracks by catalog track ID (nil: not available)
+
+	// Configuration
+	candidateCount int
+	config         *CatalogProviderConfig
+}
+
+// NewCatalogProvider creates a new CatalogProvider and loads the catalog.
+func NewCatalogProvider(shared *SharedClients, candidateCount int, settings map[string]any) (*CatalogProvider, error) {
+	if shared == nil || shared.Spotify() == nil {
+		return nil, errors.New("spotify client is required")
+	}
+
+	var config CatalogProviderConfig
+	if err := mapstructure.Decode(settings, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to decode settings")
+	}
+	if err := defaults.Set(&config); err != nil {
+		return nil, errors.Wrap(err, "failed to set defaults")
+	}
+	if err := validator.New().Struct(config); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+
+	p := &CatalogProvider{
+		shared:         shared,
+		resolved:       make(map[string]*track.Track),
+		candidateCount: candidateCount,
+		config:         &config,
+	}
+	if err := p.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// SetKeywords sets the session keywords matched against the catalog tags.
+// Implements KeywordsSetter.
+func (p *CatalogProvider) SetKeywords(keywords []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keywords = keywords
+}
+
+// GetCandidates retrieves catalog tracks, best tag matches first.
+// Only the tracks returned are looked up on Spotify, a batch at a time.
+func (p *CatalogProvider) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, existingTrackIDs map[string]bool) ([]track.Track, error) {
+	if count <= 0 {
+		return []track.Track{}, nil
+	}
+
+	if err := p.reloadIfChanged(); err != nil {
+		// Keep using the catalog loaded last
+		zlog.Error().Msgf("catalog provider: failed to reload catalog: %v", err)
+	}
+
+	ordered := p.rankedEntries(seedTracks, existingTrackIDs)
+
+	candidates := make([]track.Track, 0, count)
+	for len(ordered) > 0 && len(candidates) < count {
+		batchSize := min(count-len(candidates), len(ordered))
+		batch := ordered[:batchSize]
+		ordered = ordered[batchSize:]
+
+		for _, t := range p.resolve(ctx, batch) {
+			if !existingTrackIDs[t.ID] {
+				candidates = append(candidates, t)
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// Name returns the provider name.
+func (p *CatalogProvider) Name() string {
+	return "catalog"
+}
+
+// reloadIfChanged loads the catalog file if it changed since it was last loaded.
+func (p *CatalogProvider) reloadIfChanged() error {
+	info, err := os.Stat(p.config.Path)
+	if err != nil {
+		return errors.Wrap(err, "failed to stat catalog")
+	}
+
+	p.mu.Lock()
+	unchanged := info.ModTime().Equal(p.modTime) && info.Size() == p.size
+	p.mu.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	entries, err := catalog.Load(p.config.Path)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.entries = entries
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	// Retry tracks that were not found, the catalog may have been fixed
+	for id, t := range p.resolved {
+		if t == nil {
+			delete(p.resolved, id)
+		}
+	}
+	p.mu.Unlock()
+	zlog.Info().Msgf("catalog loaded: path=%s track_count=%d", p.config.Path, len(entries))
+	return nil
+}
+
+// rankedEntries returns the catalog entries that may be played, the ones
+// matching the most keywords and seed tags first.
+func (p *CatalogProvider) rankedEntries(seedTracks []track.Track, existingTrackIDs map[string]bool) []catalog.Entry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tags := p.matchTagsLocked(seedTracks)
+
+	type rankedEntry struct {
+		entry catalog.Entry
+		score int
+	}
+	var ranked []rankedEntry
+	for _, e := range p.entries {
+		if existingTrackIDs[e.TrackID] {
+			continue
+		}
+		if t, ok := p.resolved[e.TrackID]; ok && (t == nil || existingTrackIDs[t.ID]) {
+			continue
+		}
+		if e.Energy != nil && (*e.Energy < p.config.MinEnergy || *e.Energy > p.config.MaxEnergy) {
+			continue
+		}
+
+		score := 0
+		for tag := range tags {
+			if e.HasTag(tag) {
+				score++
+			}
+		}
+		ranked = append(ranked, rankedEntry{entry: e, score: score})
+	}
+
+	// Equally matching tracks are taken at random unless the catalog order is kept
+	if !p.config.Ordered {
+		rand.Shuffle(len(ranked), func(i, j int) {
+			ranked[i], ranked[j] = ranked[j], ranked[i]
+		})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	result := make([]catalog.Entry, len(ranked))
+	for i, r := range ranked {
+		result[i] = r.entry
+	}
+	return result
+}
+
+// matchTagsLocked returns the tags that catalog tags are matched against: the
+// session keywords, and the catalog tags and genres of the seed tracks.
+// Must be called with lock held.
+func (p *CatalogProvider) matchTagsLocked(seedTracks []track.Track) map[string]bool {
+	tags := make(map[string]bool)
+	for _, k := range p.keywords {
+		tags[strings.ToLower(strings.TrimSpace(k))] = true
+	}
+
+	seedIDs := make(map[string]bool, len(seedTracks))
+	for _, seed := range seedTracks {
+		seedIDs[seed.ID] = true
+		for _, genre := range seed.Genres {
+			tags[strings.ToLower(genre)] = true
+		}
+	}
+	for _, e := range p.entries {
+		t := p.resolved[e.TrackID]
+		if seedIDs[e.TrackID] || (t != nil && seedIDs[t.ID]) {
+			for _, tag := range e.Tags {
+				tags[tag] = true
+			}
+		}
+	}
+	return tags
+}
+
+// resolve looks up the Spotify tracks of catalog entries concurrently, with caching.
+// Entries that cannot be found are left out.
+func (p *CatalogProvider) resolve(ctx context.Context, entries []catalog.Entry) []track.Track {
+	results := make([]*track.Track, len(entries))
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		p.mu.Lock()
+		cached, ok := p.resolved[e.TrackID]
+		p.mu.Unlock()
+		if ok {
+			results[i] = cached
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, e catalog.Entry) {
+			defer wg.Done()
+			t, err := p.shared.Spotify().GetTrack(ctx, e.TrackID)
+			if err != nil {
+				zlog.Warn().Msgf("catalog provider: track not found: track_id=%s error=%v", e.TrackID, err)
+				t = nil
+			}
+			// The catalog's own attributes take precedence over the attribute source
+			if a, ok := e.Attributes(); ok && t != nil {
+				t.Attributes = &a
+			}
+			results[i] = t
+
+			p.mu.Lock()
+			p.resolved[e.TrackID] = t
+			p.mu.Unlock()
+		}(i, e)
+	}
+	wg.Wait()
+
+	tracks := make([]track.Track, 0, len(results))
+	for _, t := range results {
+		if t != nil {
+			tracks = append(tracks, *t)
+		}
+	}
+	return tracks
+}
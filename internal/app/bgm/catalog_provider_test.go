@@ -0,0 +1,209 @@
+package bgm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// catalogCSV is a catalog of the tracks of catalogSpotify, and of one that
+// Spotify does not know.
+const catalogCSV = `track_id,tags,energy
+c1,rock,0.5
+c2,jazz|chill,0.3
+c3,jazz,0.9
+missing,jazz,0.5
+c4,chill,0.1
+c5,,
+`
+
+func catalogSpotify() *fakeSpotify {
+	tracks := make(map[string]track.Track)
+	for _, t := range tracksOf("c1", "c2", "c3", "c4", "c5", "new") {
+		tracks[t.ID] = t
+	}
+	return &fakeSpotify{tracks: tracks}
+}
+
+func writeCatalogFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "catalog.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestCatalogProvider_GetCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]any
+		keywords []string
+		seeds    []track.Track
+		existing map[string]bool
+		count    int
+		want     []string
+	}{
+		{
+			name:  "catalog order",
+			count: 10,
+			want:  []string{"c1", "c2", "c3", "c4", "c5"},
+		},
+		{
+			name:     "keyword matches first",
+			keywords: []string{" Jazz"},
+			count:    10,
+			want:     []string{"c2", "c3", "c1", "c4", "c5"},
+		},
+		{
+			name:     "energy range",
+			settings: map[string]any{"min_energy": 0.2, "max_energy": 0.6},
+			count:    10,
+			// c5 has no energy level and is kept
+			want: []string{"c1", "c2", "c5"},
+		},
+		{
+			name:     "existing tracks are excluded",
+			existing: map[string]bool{"c1": true, "c3": true},
+			count:    10,
+			want:     []string{"c2", "c4", "c5"},
+		},
+		{
+			name:     "missing tracks are replaced by the next ones",
+			keywords: []string{"jazz"},
+			count:    3,
+			want:     []string{"c2", "c3", "c1"},
+		},
+		{
+			name:  "zero count",
+			count: 0,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := map[string]any{"path": writeCatalogFile(t, catalogCSV), "ordered": true}
+			for k, v := range tt.settings {
+				settings[k] = v
+			}
+			p, err := NewCatalogProvider(NewSharedClients(catalogSpotify(), nil), 10, settings)
+			require.NoError(t, err)
+			p.SetKeywords(tt.keywords)
+
+			got, err := p.GetCandidates(context.Background(), tt.count, tt.seeds, tt.existing)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, trackIDs(got))
+		})
+	}
+}
+
+func TestCatalogProvider_GetCandidates_TagMatches(t *testing.T) {
+	// The tracks are shuffled, so only the ranks are checked: tracks matching
+	// more tags come first, equally matching tracks in any order
+	tests := []struct {
+		name     string
+		keywords []string
+		seeds    []track.Track
+		existing map[string]bool
+		want     [][]string // Tracks by rank
+	}{
+		{
+			name:     "more matching tags first",
+			keywords: []string{"jazz", "chill"},
+			want:     [][]string{{"c2"}, {"c3", "c4"}, {"c1", "c5"}},
+		},
+		{
+			name:     "keywords match regardless of case and spaces",
+			keywords: []string{" ROCK "},
+			want:     [][]string{{"c1"}, {"c2", "c3", "c4", "c5"}},
+		},
+		{
+			name:  "seed genres match regardless of case",
+			seeds: []track.Track{{ID: "s1", Genres: []string{"Jazz"}}},
+			want:  [][]string{{"c2", "c3"}, {"c1", "c4", "c5"}},
+		},
+		{
+			name:     "catalog tags of seed tracks",
+			seeds:    []track.Track{{ID: "c4"}},
+			existing: map[string]bool{"c4": true},
+			want:     [][]string{{"c2"}, {"c1", "c3", "c5"}},
+		},
+		{
+			name: "no tags to match",
+			want: [][]string{{"c1", "c2", "c3", "c4", "c5"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := map[string]any{"path": writeCatalogFile(t, catalogCSV)}
+			p, err := NewCatalogProvider(NewSharedClients(catalogSpotify(), nil), 10, settings)
+			require.NoError(t, err)
+			p.SetKeywords(tt.keywords)
+
+			candidates, err := p.GetCandidates(context.Background(), 10, tt.seeds, tt.existing)
+			require.NoError(t, err)
+			got := trackIDs(candidates)
+			for _, rank := range tt.want {
+				require.GreaterOrEqual(t, len(got), len(rank))
+				assert.ElementsMatch(t, rank, got[:len(rank)])
+				got = got[len(rank):]
+			}
+			assert.Empty(t, got)
+		})
+	}
+}
+
+func TestCatalogProvider_GetCandidates_Attributes(t *testing.T) {
+	settings := map[string]any{"path": writeCatalogFile(t, catalogCSV), "ordered": true}
+	p, err := NewCatalogProvider(NewSharedClients(catalogSpotify(), nil), 10, settings)
+	require.NoError(t, err)
+
+	got, err := p.GetCandidates(context.Background(), 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 5)
+
+	// The catalog's energy levels are used as the track attributes
+	require.NotNil(t, got[0].Attributes)
+	assert.Equal(t, 0.5, got[0].Attributes.Energy)
+	assert.Nil(t, got[4].Attributes)
+}
+
+func TestCatalogProvider_GetCandidates_ReloadsCatalog(t *testing.T) {
+	path := writeCatalogFile(t, catalogCSV)
+	p, err := NewCatalogProvider(NewSharedClients(catalogSpotify(), nil), 10, map[string]any{"path": path, "ordered": true})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("track_id\nnew\nc1\n"), 0644))
+	got, err := p.GetCandidates(context.Background(), 10, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new", "c1"}, trackIDs(got))
+}
+
+func TestNewCatalogProvider_Validation(t *testing.T) {
+	shared := NewSharedClients(catalogSpotify(), nil)
+	path := writeCatalogFile(t, catalogCSV)
+
+	tests := []struct {
+		name     string
+		shared   *SharedClients
+		settings map[string]any
+	}{
+		{name: "no spotify client", shared: NewSharedClients(nil, nil), settings: map[string]any{"path": path}},
+		{name: "no path", shared: shared, settings: map[string]any{}},
+		{name: "missing file", shared: shared, settings: map[string]any{"path": filepath.Join(t.TempDir(), "none.csv")}},
+		{name: "min energy above max", shared: shared, settings: map[string]any{"path": path, "min_energy": 0.8, "max_energy": 0.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCatalogProvider(tt.shared, 10, tt.settings)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -8,23 +8,46 @@ import (
 )
 
 // NewProviderChainFromConfig creates a provider chain from configuration.
-func NewProviderChainFromConfig(cfg *config.Config, spotify SpotifyClient) (*ProviderChain, error) {
-	if len(cfg.BGM.Providers) == 0 {
+// Providers use the given shared clients, so chains of different rooms share API clients and caches.
+func NewProviderChainFromConfig(cfg *config.Config, shared *SharedClients) (*ProviderChain, error) {
+	return NewProviderChainFromProviders(cfg.BGM.Providers, cfg.BGM, shared)
+}
+
+// NewProviderChainFromProviders creates a provider chain from a list of provider
+// configurations, with the other settings taken from bgmCfg.
+func NewProviderChainFromProviders(pcfgs []config.ProviderConfig, bgmCfg config.BGMConfig, shared *SharedClients) (*ProviderChain, error) {
+	candidateCount := bgmCfg.CandidateCount
+	if len(pcfgs) == 0 {
 		return nil, errors.New("no BGM providers configured")
 	}
 
 	var providers []ProviderWithMetadata
 
-	for i, pcfg := range cfg.BGM.Providers {
+	for i, pcfg := range pcfgs {
 		var provider Provider
 		var err error
 		zlog.Debug().Msgf("creating BGM provider: index=%d type=%s settings=%+v", i+1, pcfg.Type, pcfg.Settings)
 		switch pcfg.Type {
 		case "playlist":
-			provider, err = NewPlaylistProvider(spotify, cfg.BGM.CandidateCount, pcfg.Settings)
+			provider, err = NewPlaylistProvider(shared.Spotify(), candidateCount, pcfg.Settings)
 
 		case "lastfm":
-			provider, err = NewLastFmProvider(spotify, cfg.BGM.CandidateCount, pcfg.Settings)
+			provider, err = NewLastFmProvider(shared, candidateCount, pcfg.Settings)
+
+		case "spotify_related":
+			provider, err = NewSpotifyRelatedProvider(shared, candidateCount, pcfg.Settings)
+
+		case "keywords":
+			provider, err = NewKeywordsProvider(shared, candidateCount, pcfg.Settings)
+
+		case "audience":
+			provider, err = NewAudienceProvider(shared, candidateCount, pcfg.Settings)
+
+		case "library":
+			provider, err = NewLibraryProvider(shared, candidateCount, pcfg.Settings)
+
+		case "catalog":
+			provider, err = NewCatalogProvider(shared, candidateCount, pcfg.Settings)
 
 		default:
 			return nil, errors.Newf("unsupported provider type: %s (provider index %d)", pcfg.Type, i)
@@ -37,10 +60,11 @@ func NewProviderChainFromConfig(cfg *config.Config, spotify SpotifyClient) (*Pro
 		providers = append(providers, ProviderWithMetadata{
 			Provider:    provider,
 			DisplayName: pcfg.DisplayName,
+			Weight:      pcfg.Weight,
 		})
 
 		zlog.Info().Msgf("registered BGM provider: index=%d type=%s display_name=%s", i+1, pcfg.Type, pcfg.DisplayName)
 	}
 
-	return NewProviderChain(providers), nil
+	return NewProviderChain(providers, Strategy(bgmCfg.Strategy), bgmCfg.SeedTrackCount), nil
 }
@@ -0,0 +1,209 @@
+package bgm
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/creasty/defaults"
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// Keyword sources of KeywordsProvider.
+const (
+	keywordSourceTrack    = "track"    // Spotify track search
+	keywordSourcePlaylist = "playlist" // Tracks of Spotify playlists found by search
+	keywordSourceGenre    = "genre"    // Spotify track search by genre
+	keywordSourceTag      = "tag"      // Last.fm top tracks of the tag
+)
+
+type KeywordsProviderConfig struct {
+	Sources     []string `yaml:"sources" mapstructure:"sources" default:"[\"track\",\"playlist\",\"genre\",\"tag\"]" validate:"min=1,dive,oneof=track playlist genre tag"`
+	APIKey      string   `yaml:"api_key" mapstructure:"api_key"` // Last.fm API key for the "tag" source (the source is skipped without it)
+	SearchLimit int      `yaml:"search_limit" mapstructure:"search_limit" default:"20" validate:"gte=1,lte=50"`
+}
+
+// KeywordsProvider provides BGM tracks matching the session keywords, so that BGM
+// stays on-theme even without seed tracks. Each call uses the next keyword in turn.
+type KeywordsProvider struct {
+	shared *SharedClients
+	lastfm LastFmClient // nil: the "tag" source is skipped
+
+	mu       sync.Mutex
+	keywords []string
+	next     int // Index of the keyword used by the next call
+
+	// Configuration
+	candidateCount int
+	config         *KeywordsProviderConfig
+}
+
+// NewKeywordsProvider creates a new KeywordsProvider.
+// The session keywords are set with SetKeywords.
+func NewKeywordsProvider(shared *SharedClients, candidateCount int, settings map[string]any) (*KeywordsProvider, error) {
+	if shared == nil || shared.Spotify() == nil {
+		return nil, errors.New("spotify client is required")
+	}
+
+	var config KeywordsProviderConfig
+	if err := mapstructure.Decode(settings, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to decode settings")
+	}
+	if err := defaults.Set(&config); err != nil {
+		return nil, errors.Wrap(err, "failed to set defaults")
+	}
+	if err := validator.New().Struct(config); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+
+	p := &KeywordsProvider{
+		shared:         shared,
+		candidateCount: candidateCount,
+		config:         &config,
+	}
+	if config.APIKey != "" {
+		lastfmClient, err := shared.LastFm(config.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		p.lastfm = lastfmClient
+	}
+	return p, nil
+}
+
+// SetKeywords sets the session keywords the provider searches for.
+// Implements KeywordsSetter.
+func (p *KeywordsProvider) SetKeywords(keywords []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keywords = keywords
+	p.next = 0
+}
+
+// GetCandidates retrieves tracks matching the next session keyword from every source.
+// Tracks found by several sources are preferred. Seed tracks are not used.
+func (p *KeywordsProvider) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, existingTrackIDs map[string]bool) ([]track.Track, error) {
+	if count <= 0 {
+		return []track.Track{}, nil
+	}
+
+	keyword, ok := p.nextKeyword()
+	if !ok {
+		return []track.Track{}, nil
+	}
+	zlog.Debug().Msgf("keywords provider: keyword=%s", keyword)
+
+	scoreMap := make(map[string]*ScoredTrack)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, source := range p.config.Sources {
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+			tracks := p.search(ctx, source, keyword)
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, t := range deduplicateByID(tracks) {
+				if existingTrackIDs[t.ID] {
+					continue
+				}
+				if existing, ok := scoreMap[t.ID]; ok {
+					existing.Score++
+				} else {
+					scoreMap[t.ID] = &ScoredTrack{Track: t, Score: 1}
+				}
+			}
+		}(source)
+	}
+	wg.Wait()
+
+	if len(scoreMap) == 0 {
+		return []track.Track{}, nil
+	}
+
+	scored := make([]ScoredTrack, 0, len(scoreMap))
+	for _, s := range scoreMap {
+		scored = append(scored, *s)
+	}
+	return pickVaried(scored, count), nil
+}
+
+// Name returns the provider name.
+func (p *KeywordsProvider) Name() string {
+	return "keywords"
+}
+
+// nextKeyword returns the keyword to use and moves on to the next one.
+func (p *KeywordsProvider) nextKeyword() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.keywords) == 0 {
+		return "", false
+	}
+	keyword := p.keywords[p.next%len(p.keywords)]
+	p.next++
+	return keyword, true
+}
+
+// search retrieves tracks for a keyword from one source. Errors are logged and skipped.
+func (p *KeywordsProvider) search(ctx context.Context, source, keyword string) []track.Track {
+	spotify := p.shared.Spotify()
+	limit := p.config.SearchLimit
+
+	switch source {
+	case keywordSourceTrack:
+		tracks, err := spotify.Search(ctx, keyword, "track", limit)
+		if err != nil {
+			zlog.Debug().Msgf("keywords provider: track search failed: keyword=%s error=%v", keyword, err)
+		}
+		return tracks
+
+	case keywordSourceGenre:
+		tracks, err := spotify.Search(ctx, fmt.Sprintf("genre:%q", keyword), "track", limit)
+		if err != nil {
+			zlog.Debug().Msgf("keywords provider: genre search failed: keyword=%s error=%v", keyword, err)
+		}
+		return tracks
+
+	case keywordSourcePlaylist:
+		playlistIDs, err := spotify.SearchPlaylists(ctx, keyword, 10)
+		if err != nil || len(playlistIDs) == 0 {
+			zlog.Debug().Msgf("keywords provider: playlist search failed: keyword=%s error=%v", keyword, err)
+			return nil
+		}
+		// A different playlist each time adds variety
+		playlistID := playlistIDs[rand.IntN(len(playlistIDs))]
+		tracks, err := spotify.GetPlaylistTracksRandom(ctx, playlistID, limit)
+		if err != nil {
+			zlog.Debug().Msgf("keywords provider: playlist tracks failed: playlist=%s error=%v", playlistID, err)
+		}
+		return tracks
+
+	case keywordSourceTag:
+		if p.lastfm == nil {
+			return nil
+		}
+		lfmTracks, err := p.lastfm.GetTopTracks(ctx, keyword, limit)
+		if err != nil {
+			zlog.Debug().Msgf("keywords provider: tag lookup failed: keyword=%s error=%v", keyword, err)
+			return nil
+		}
+		var tracks []track.Track
+		for _, lfmTrack := range lfmTracks {
+			if t := p.shared.SearchTrack(ctx, lfmTrack.Name, lfmTrack.Artist); t != nil {
+				tracks = append(tracks, *t)
+			}
+		}
+		return tracks
+	}
+	return nil
+}
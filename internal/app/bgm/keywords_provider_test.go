@@ -0,0 +1,126 @@
+package bgm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func TestKeywordsProvider_GetCandidates(t *testing.T) {
+	client := &fakeSpotify{
+		searches: map[string][]track.Track{
+			"jazz":         tracksOf("j1", "j2", "j2"),
+			`genre:"jazz"`: tracksOf("j2", "j3"),
+			"funk":         tracksOf("f1"),
+		},
+		found:     map[string][]string{"jazz": {"jazz_playlist"}},
+		playlists: map[string][]track.Track{"jazz_playlist": tracksOf("j3", "j4")},
+	}
+
+	tests := []struct {
+		name     string
+		settings map[string]any
+		keywords []string
+		existing map[string]bool
+		want     []string
+	}{
+		{
+			name:     "every source",
+			keywords: []string{"jazz"},
+			want:     []string{"j1", "j2", "j3", "j4"},
+		},
+		{
+			name:     "configured sources only",
+			settings: map[string]any{"sources": []string{"genre"}},
+			keywords: []string{"jazz"},
+			want:     []string{"j2", "j3"},
+		},
+		{
+			name:     "existing tracks are excluded",
+			settings: map[string]any{"sources": []string{"track", "genre"}},
+			keywords: []string{"jazz"},
+			existing: map[string]bool{"j2": true},
+			want:     []string{"j1", "j3"},
+		},
+		{
+			name:     "search limit",
+			settings: map[string]any{"sources": []string{"track"}, "search_limit": 1},
+			keywords: []string{"jazz"},
+			want:     []string{"j1"},
+		},
+		{
+			name:     "failed lookups are skipped",
+			keywords: []string{"funk"},
+			want:     []string{"f1"},
+		},
+		{
+			name: "no keywords",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewKeywordsProvider(NewSharedClients(client, nil), 10, tt.settings)
+			require.NoError(t, err)
+			p.SetKeywords(tt.keywords)
+
+			got, err := p.GetCandidates(context.Background(), 10, nil, tt.existing)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, trackIDs(got))
+		})
+	}
+}
+
+func TestKeywordsProvider_GetCandidates_Queries(t *testing.T) {
+	tests := []struct {
+		source string
+		want   []string
+	}{
+		{source: "track", want: []string{"search:city pop"}},
+		{source: "genre", want: []string{`search:genre:"city pop"`}},
+		{source: "playlist", want: []string{"playlists:city pop", "playlist:cp_playlist"}},
+		// Without a Last.fm API key the tag source makes no lookups
+		{source: "tag", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			client := &fakeSpotify{
+				searches: map[string][]track.Track{
+					"city pop":         tracksOf("t1"),
+					`genre:"city pop"`: tracksOf("g1"),
+				},
+				found:     map[string][]string{"city pop": {"cp_playlist"}},
+				playlists: map[string][]track.Track{"cp_playlist": tracksOf("p1")},
+			}
+			p, err := NewKeywordsProvider(NewSharedClients(client, nil), 10, map[string]any{"sources": []string{tt.source}})
+			require.NoError(t, err)
+			p.SetKeywords([]string{"city pop"})
+
+			_, err = p.GetCandidates(context.Background(), 10, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, client.takeCalls())
+		})
+	}
+}
+
+func TestKeywordsProvider_GetCandidates_RotatesKeywords(t *testing.T) {
+	client := &fakeSpotify{searches: map[string][]track.Track{
+		"jazz": tracksOf("j1"),
+		"funk": tracksOf("f1"),
+	}}
+	p, err := NewKeywordsProvider(NewSharedClients(client, nil), 10, map[string]any{"sources": []string{"track"}})
+	require.NoError(t, err)
+	p.SetKeywords([]string{"jazz", "funk"})
+
+	for _, want := range []string{"jazz", "funk", "jazz"} {
+		_, err := p.GetCandidates(context.Background(), 10, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"search:" + want}, client.takeCalls(), "one keyword per call")
+	}
+}
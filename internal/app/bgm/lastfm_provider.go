@@ -4,7 +4,6 @@ import (
 	"context"
 	cryptoRand "crypto/rand"
 	"encoding/binary"
-	"fmt"
 	"math/rand"
 	"sort"
 	"sync"
@@ -38,28 +37,20 @@ type LastFmProviderConfig struct {
 // LastFmProvider provides BGM tracks using Last.fm API with hybrid scoring.
 // Combines tag-based and similar-based strategies with configurable weights.
 type LastFmProvider struct {
-	lastfm  LastFmClient
-	spotify SpotifyClient
+	lastfm LastFmClient
+	shared *SharedClients
 
-	// Cache for Spotify search results
-	spotifySearchCache map[string]*track.Track
-	candidateCache     []track.Track
-	cacheMutex         sync.RWMutex
+	candidateCache []track.Track
 
 	// Configuration
 	candidateCount int
 	config         *LastFmProviderConfig
 }
 
-// ScoredTrack represents a track with its hybrid score.
-type ScoredTrack struct {
-	Track track.Track
-	Score float64
-}
-
 // NewLastFmProvider creates a new LastFmProvider.
-func NewLastFmProvider(spotify SpotifyClient, candidateCount int, settings map[string]any) (*LastFmProvider, error) {
-	if spotify == nil {
+// The Last.fm client and Spotify search cache are taken from the shared clients.
+func NewLastFmProvider(shared *SharedClients, candidateCount int, settings map[string]any) (*LastFmProvider, error) {
+	if shared == nil || shared.Spotify() == nil {
 		return nil, errors.New("spotify client is required")
 	}
 	if len(settings) == 0 {
@@ -80,18 +71,16 @@ func NewLastFmProvider(spotify SpotifyClient, candidateCount int, settings map[s
 		return nil, errors.New("tag weight and similar weight must sum to 1.0")
 	}
 
-	lastfmClient, err := lastfm.New(lastfm.Config{APIKey: config.APIKey})
+	lastfmClient, err := shared.LastFm(config.APIKey)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create last.fm client")
+		return nil, err
 	}
 
 	return &LastFmProvider{
-		spotify:            spotify,
-		lastfm:             lastfmClient,
-		spotifySearchCache: make(map[string]*track.Track),
-		candidateCache:     make([]track.Track, 0),
-		cacheMutex:         sync.RWMutex{},
-		candidateCount:     candidateCount,
+		lastfm:         lastfmClient,
+		shared:         shared,
+		candidateCache: make([]track.Track, 0),
+		candidateCount: candidateCount,
 
 		config: &config}, nil
 }
@@ -119,47 +108,20 @@ func (p *LastFmProvider) GetCandidates(ctx context.Context, count int, seedTrack
 	similarCandidates := p.getSimilarBasedCandidates(ctx, seedTracks, existingTrackIDs)
 
 	// 3. Score and merge
-	scored := p.scoreAndMerge(tagCandidates, similarCandidates)
+	scored := mergeScores(tagCandidates, p.config.TagWeight, similarCandidates, p.config.SimilarWeight)
 
 	if len(scored) == 0 {
 		return []track.Track{}, nil
 	}
 
-	// 4. Sort by score (descending)
-	sort.Slice(scored, func(i, j int) bool {
-		return scored[i].Score > scored[j].Score
-	})
-
-	// 5. Return random selection from top N*2 candidates to add variety
-	// Instead of always taking the absolute top N, we take top N*2 and pick randomly
-	poolSize := count * 2
-	if poolSize > len(scored) {
-		poolSize = len(scored)
-	}
-
-	topCandidates := scored[:poolSize]
-
-	// Initialize RNG
-	var cryptoSeed int64
-	var buf [8]byte
-	if _, err := cryptoRand.Read(buf[:]); err == nil {
-		cryptoSeed = int64(binary.LittleEndian.Uint64(buf[:]))
-	} else {
-		cryptoSeed = time.Now().UnixNano()
-	}
-	rng := rand.New(rand.NewSource(cryptoSeed))
-
-	// Shuffle top candidates
-	rng.Shuffle(len(topCandidates), func(i, j int) {
-		topCandidates[i], topCandidates[j] = topCandidates[j], topCandidates[i]
-	})
-
-	result := make([]track.Track, 0, count)
-	for i := 0; i < count && i < len(topCandidates); i++ {
-		result = append(result, topCandidates[i].Track)
-	}
+	// 4. Return random selection from top N*2 candidates to add variety
+	return pickVaried(scored, count), nil
+}
 
-	return result, nil
+// SeedCount returns the number of seed tracks the provider uses.
+// Implements SeedCounter.
+func (p *LastFmProvider) SeedCount() int {
+	return p.config.SeedTrackCount
 }
 
 // Name returns the provider name.
@@ -219,7 +181,7 @@ func (p *LastFmProvider) getTagBasedCandidates(ctx context.Context, seedTracks [
 	}
 	wg.Wait()
 
-	return p.deduplicateByID(candidates)
+	return deduplicateByID(candidates)
 }
 
 // getSimilarBasedCandidates retrieves candidates using similar-based strategy.
@@ -255,42 +217,7 @@ func (p *LastFmProvider) getSimilarBasedCandidates(ctx context.Context, seedTrac
 	}
 	wg.Wait()
 
-	return p.deduplicateByID(candidates)
-}
-
-// scoreAndMerge scores and merges tag-based and similar-based candidates.
-func (p *LastFmProvider) scoreAndMerge(tagCandidates, similarCandidates []track.Track) []ScoredTrack {
-	scoreMap := make(map[string]*ScoredTrack)
-
-	// Add tag candidates with tag weight
-	for _, t := range tagCandidates {
-		scoreMap[t.ID] = &ScoredTrack{
-			Track: t,
-			Score: p.config.TagWeight,
-		}
-	}
-
-	// Add similar candidates (merge if already exists)
-	for _, t := range similarCandidates {
-		if existing, ok := scoreMap[t.ID]; ok {
-			// Track found in both strategies - add similar weight
-			existing.Score += p.config.SimilarWeight
-		} else {
-			// New track from similar strategy
-			scoreMap[t.ID] = &ScoredTrack{
-				Track: t,
-				Score: p.config.SimilarWeight,
-			}
-		}
-	}
-
-	// Convert map to slice
-	result := make([]ScoredTrack, 0, len(scoreMap))
-	for _, scored := range scoreMap {
-		result = append(result, *scored)
-	}
-
-	return result
+	return deduplicateByID(candidates)
 }
 
 // sortAndTakeTopTags sorts tags by count and returns top N tag names.
@@ -319,46 +246,7 @@ func (p *LastFmProvider) sortAndTakeTopTags(tagCounts map[string]int, topN int)
 
 // searchOnSpotify searches for a track on Spotify with caching.
 func (p *LastFmProvider) searchOnSpotify(ctx context.Context, trackName, artistName string) *track.Track {
-	key := fmt.Sprintf("%s:%s", trackName, artistName)
-
-	// Check cache
-	p.cacheMutex.RLock()
-	if cached, ok := p.spotifySearchCache[key]; ok {
-		p.cacheMutex.RUnlock()
-		return cached
-	}
-	p.cacheMutex.RUnlock()
-
-	// Search on Spotify to get track ID
-	query := fmt.Sprintf("track:%s artist:%s", trackName, artistName)
-	results, err := p.spotify.Search(ctx, query, "track", 1)
-	if err != nil || len(results) == 0 {
-		// Cache nil to avoid repeated failed searches
-		p.cacheMutex.Lock()
-		p.spotifySearchCache[key] = nil
-		p.cacheMutex.Unlock()
-		return nil
-	}
-
-	// Get full track information including AvailableMarkets
-	// Search API returns SimpleTrack without market information,
-	// so we need to call GetTrack to get FullTrack with Markets
-	trackID := results[0].ID
-	fullTrack, err := p.spotify.GetTrack(ctx, trackID)
-	if err != nil {
-		// Cache nil to avoid repeated failed searches
-		p.cacheMutex.Lock()
-		p.spotifySearchCache[key] = nil
-		p.cacheMutex.Unlock()
-		return nil
-	}
-
-	// Cache result
-	p.cacheMutex.Lock()
-	p.spotifySearchCache[key] = fullTrack
-	p.cacheMutex.Unlock()
-
-	return fullTrack
+	return p.shared.SearchTrack(ctx, trackName, artistName)
 }
 
 // getChartBasedCandidates retrieves candidates using global chart strategy.
@@ -401,20 +289,5 @@ func (p *LastFmProvider) getChartBasedCandidates(ctx context.Context, count int,
 		}
 	}
 
-	return p.deduplicateByID(candidates), nil
-}
-
-// deduplicateByID removes duplicate tracks by ID.
-func (p *LastFmProvider) deduplicateByID(tracks []track.Track) []track.Track {
-	seen := make(map[string]bool)
-	result := make([]track.Track, 0, len(tracks))
-
-	for _, t := range tracks {
-		if !seen[t.ID] {
-			seen[t.ID] = true
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return deduplicateByID(candidates), nil
 }
@@ -0,0 +1,130 @@
+package bgm
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/creasty/defaults"
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/library"
+)
+
+type LibraryProviderConfig struct {
+	ExcludeDays  int     `yaml:"exclude_days" mapstructure:"exclude_days" default:"7" validate:"gte=0"`
+	MinRequests  int     `yaml:"min_requests" mapstructure:"min_requests" default:"1" validate:"gte=1"`
+	MaxSkipRatio float64 `yaml:"max_skip_ratio" mapstructure:"max_skip_ratio" default:"0.5" validate:"gte=0,lte=1.0"`
+}
+
+// LibraryProvider provides BGM tracks from the library of past sessions: tracks
+// listeners requested before and that were well received (played again rather
+// than skipped). Tracks played recently are left out so that sessions do not repeat.
+type LibraryProvider struct {
+	shared  *SharedClients
+	library *library.Library
+
+	// Configuration
+	candidateCount int
+	config         *LibraryProviderConfig
+}
+
+// NewLibraryProvider creates a new LibraryProvider.
+// It requires the library to be configured.
+func NewLibraryProvider(shared *SharedClients, candidateCount int, settings map[string]any) (*LibraryProvider, error) {
+	if shared == nil || shared.Spotify() == nil {
+		return nil, errors.New("spotify client is required")
+	}
+	if shared.Library() == nil {
+		return nil, errors.New("library is not configured (library.path)")
+	}
+
+	var config LibraryProviderConfig
+	if err := mapstructure.Decode(settings, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to decode settings")
+	}
+	if err := defaults.Set(&config); err != nil {
+		return nil, errors.Wrap(err, "failed to set defaults")
+	}
+	if err := validator.New().Struct(config); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+
+	return &LibraryProvider{
+		shared:         shared,
+		library:        shared.Library(),
+		candidateCount: candidateCount,
+		config:         &config,
+	}, nil
+}
+
+// GetCandidates retrieves well-received past requests from the library.
+// Seed tracks are not used.
+func (p *LibraryProvider) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, existingTrackIDs map[string]bool) ([]track.Track, error) {
+	if count <= 0 {
+		return []track.Track{}, nil
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -p.config.ExcludeDays)
+	var scored []ScoredTrack
+	for _, e := range p.library.Entries() {
+		if existingTrackIDs[e.TrackID] {
+			continue
+		}
+		if p.config.ExcludeDays > 0 && e.LastPlayedAt.After(cutoff) {
+			continue
+		}
+		score, ok := p.score(e)
+		if !ok {
+			continue
+		}
+		scored = append(scored, ScoredTrack{
+			Track: track.Track{ID: e.TrackID, Name: e.Name, Artists: e.Artists},
+			Score: score,
+		})
+	}
+	if len(scored) == 0 {
+		return []track.Track{}, nil
+	}
+
+	// The library only keeps what identifies a track: get the current track
+	// information (availability, duration, etc.) for the picked tracks
+	picked := pickVaried(scored, count)
+	candidates := make([]track.Track, 0, len(picked))
+	for _, t := range picked {
+		full, err := p.shared.Spotify().GetTrack(ctx, t.ID)
+		if err != nil {
+			zlog.Debug().Msgf("library provider: failed to get track: track_id=%s error=%v", t.ID, err)
+			continue
+		}
+		candidates = append(candidates, *full)
+	}
+	return candidates, nil
+}
+
+// Name returns the provider name.
+func (p *LibraryProvider) Name() string {
+	return "library"
+}
+
+// score rates how well a track was received: the more it was requested and
+// played again, and the less it was skipped, the higher.
+// Returns false for tracks that were not requested enough or skipped too often.
+func (p *LibraryProvider) score(e library.Entry) (float64, bool) {
+	requests := e.Requests()
+	if requests < p.config.MinRequests {
+		return 0, false
+	}
+
+	skipRatio := 0.0
+	if plays := e.TotalPlays() + e.Replays; plays > 0 {
+		skipRatio = float64(e.Skips) / float64(plays)
+	}
+	if skipRatio > p.config.MaxSkipRatio {
+		return 0, false
+	}
+	return float64(requests+e.Replays) * (1 - skipRatio), true
+}
@@ -3,8 +3,10 @@ package bgm
 
 import (
 	"context"
+	"time"
 
 	"github.com/osa030/19box/internal/domain/track"
+	spotifyapi "github.com/osa030/19box/internal/infra/spotify"
 )
 
 // Provider is the interface for BGM track providers.
@@ -21,9 +23,38 @@ type Provider interface {
 	Name() string
 }
 
+// SeedCounter is implemented by providers that use seed tracks, to declare how
+// many they want. Other providers receive the default number of seeds.
+type SeedCounter interface {
+	SeedCount() int
+}
+
+// KeywordsSetter is implemented by providers that select tracks by the session keywords.
+type KeywordsSetter interface {
+	SetKeywords(keywords []string)
+}
+
+// AudienceSource reports what the listeners who are in the session have requested.
+type AudienceSource interface {
+	// AudienceTracks returns the tracks requested by each present listener, keyed
+	// by listener ID, most recent first. Listeners who are not subscribed to
+	// notifications count as present if they requested a track within activeWithin.
+	AudienceTracks(activeWithin time.Duration) map[string][]track.Track
+}
+
+// AudienceSetter is implemented by providers that select tracks for the listeners in the session.
+type AudienceSetter interface {
+	SetAudience(audience AudienceSource)
+}
+
 // SpotifyClient defines the interface for Spotify operations needed by BGM providers.
 type SpotifyClient interface {
 	GetPlaylistTracksRandom(ctx context.Context, playlistURL string, count int) ([]track.Track, error)
 	Search(ctx context.Context, query string, searchType string, limit int) ([]track.Track, error)
+	SearchPlaylists(ctx context.Context, query string, limit int) ([]string, error)
 	GetTrack(ctx context.Context, trackID string, market ...string) (*track.Track, error)
+	GetRelatedArtists(ctx context.Context, artistID string) ([]spotifyapi.Artist, error)
+	GetArtists(ctx context.Context, artistIDs []string) ([]spotifyapi.Artist, error)
+	GetArtistTopTracks(ctx context.Context, artistID string) ([]track.Track, error)
+	GetAudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error)
 }
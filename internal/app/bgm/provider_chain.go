@@ -2,6 +2,7 @@ package bgm
 
 import (
 	"context"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	zlog "github.com/rs/zerolog/log"
@@ -19,22 +20,77 @@ type CandidateWithSource struct {
 type ProviderWithMetadata struct {
 	Provider    Provider
 	DisplayName string
+	Weight      float64 // Relative chance of being picked by StrategyWeighted
 }
 
-// ProviderChain tries multiple providers in order until enough candidates are found.
+// ProviderChain asks multiple providers for candidates, as its strategy decides.
 type ProviderChain struct {
-	providers []ProviderWithMetadata
+	providers        []ProviderWithMetadata
+	strategy         Strategy
+	defaultSeedCount int
+
+	mu           sync.Mutex
+	nextProvider int            // First provider of the next call (StrategyRoundRobin)
+	random       func() float64 // Source of the weighted draws (StrategyWeighted); nil uses the global source
+}
+
+// SeedCount returns the number of seed tracks the providers need, at least defaultCount.
+func (c *ProviderChain) SeedCount(defaultCount int) int {
+	count := defaultCount
+	for _, pm := range c.providers {
+		if sc, ok := pm.Provider.(SeedCounter); ok {
+			count = max(count, sc.SeedCount())
+		}
+	}
+	return count
+}
+
+// SetKeywords gives the session keywords to the providers that use them.
+func (c *ProviderChain) SetKeywords(keywords []string) {
+	for _, pm := range c.providers {
+		if ks, ok := pm.Provider.(KeywordsSetter); ok {
+			ks.SetKeywords(keywords)
+		}
+	}
+}
+
+// SetAudience gives the source of the present listeners' requests to the providers that use it.
+func (c *ProviderChain) SetAudience(audience AudienceSource) {
+	for _, pm := range c.providers {
+		if as, ok := pm.Provider.(AudienceSetter); ok {
+			as.SetAudience(audience)
+		}
+	}
+}
+
+// seedsFor returns the seed tracks given to a provider: as many as it declares,
+// or defaultCount. seedTracks are ordered by relevance.
+func seedsFor(p Provider, seedTracks []track.Track, defaultCount int) []track.Track {
+	count := defaultCount
+	if sc, ok := p.(SeedCounter); ok {
+		count = sc.SeedCount()
+	}
+	if len(seedTracks) > count {
+		return seedTracks[:count]
+	}
+	return seedTracks
 }
 
 // NewProviderChain creates a new provider chain.
-func NewProviderChain(providers []ProviderWithMetadata) *ProviderChain {
+// An empty strategy asks every provider (StrategyAll).
+// defaultSeedCount is the number of seeds given to providers that do not declare it.
+func NewProviderChain(providers []ProviderWithMetadata, strategy Strategy, defaultSeedCount int) *ProviderChain {
 	return &ProviderChain{
-		providers: providers,
+		providers:        providers,
+		strategy:         strategy,
+		defaultSeedCount: defaultSeedCount,
 	}
 }
 
-// GetCandidates retrieves candidates from all providers.
-// All providers are tried to maximize candidate pool for filtering.
+// GetCandidates retrieves candidates from the providers in the order of the strategy.
+// With StrategyAll, all providers are tried to maximize candidate pool for filtering;
+// the other strategies stop once count candidates are found.
+// seedTracks are ordered by relevance; each provider gets as many as it wants.
 func (c *ProviderChain) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, excludeIDs map[string]bool) ([]CandidateWithSource, error) {
 	var allCandidates []CandidateWithSource
 	currentExcludeIDs := make(map[string]bool)
@@ -42,11 +98,14 @@ func (c *ProviderChain) GetCandidates(ctx context.Context, count int, seedTracks
 		currentExcludeIDs[k] = v
 	}
 
-	for i, pm := range c.providers {
+	for i, pm := range c.providerOrder() {
+		if c.strategy.stopsWhenEnough() && len(allCandidates) >= count {
+			break
+		}
 		zlog.Debug().Msgf("trying provider: index=%d total=%d name=%s provider_type=%s",
 			i+1, len(c.providers), pm.DisplayName, pm.Provider.Name())
 
-		candidates, err := pm.Provider.GetCandidates(ctx, count, seedTracks, currentExcludeIDs)
+		candidates, err := pm.Provider.GetCandidates(ctx, count, seedsFor(pm.Provider, seedTracks, c.defaultSeedCount), currentExcludeIDs)
 		if err != nil {
 			zlog.Warn().Msgf("provider failed, trying next: provider=%s error=%v", pm.DisplayName, err)
 			continue
@@ -0,0 +1,104 @@
+package bgm
+
+import (
+	cryptoRand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// ScoredTrack represents a track with its hybrid score.
+type ScoredTrack struct {
+	Track track.Track
+	Score float64
+}
+
+// mergeScores scores candidates found by two strategies with their weights.
+// A track found by both strategies gets both weights.
+func mergeScores(first []track.Track, firstWeight float64, second []track.Track, secondWeight float64) []ScoredTrack {
+	scoreMap := make(map[string]*ScoredTrack)
+
+	// Add first candidates with first weight
+	for _, t := range first {
+		scoreMap[t.ID] = &ScoredTrack{
+			Track: t,
+			Score: firstWeight,
+		}
+	}
+
+	// Add second candidates (merge if already exists)
+	for _, t := range second {
+		if existing, ok := scoreMap[t.ID]; ok {
+			// Track found in both strategies - add second weight
+			existing.Score += secondWeight
+		} else {
+			// New track from second strategy
+			scoreMap[t.ID] = &ScoredTrack{
+				Track: t,
+				Score: secondWeight,
+			}
+		}
+	}
+
+	// Convert map to slice
+	result := make([]ScoredTrack, 0, len(scoreMap))
+	for _, scored := range scoreMap {
+		result = append(result, *scored)
+	}
+
+	return result
+}
+
+// pickVaried returns count tracks picked at random among the 2*count best scored tracks.
+// Instead of always taking the absolute top N, it adds variety between calls.
+func pickVaried(scored []ScoredTrack, count int) []track.Track {
+	// Sort by score (descending)
+	sort.Slice(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
+	})
+
+	poolSize := count * 2
+	if poolSize > len(scored) {
+		poolSize = len(scored)
+	}
+
+	topCandidates := scored[:poolSize]
+
+	// Initialize RNG
+	var cryptoSeed int64
+	var buf [8]byte
+	if _, err := cryptoRand.Read(buf[:]); err == nil {
+		cryptoSeed = int64(binary.LittleEndian.Uint64(buf[:]))
+	} else {
+		cryptoSeed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(cryptoSeed))
+
+	// Shuffle top candidates
+	rng.Shuffle(len(topCandidates), func(i, j int) {
+		topCandidates[i], topCandidates[j] = topCandidates[j], topCandidates[i]
+	})
+
+	result := make([]track.Track, 0, count)
+	for i := 0; i < count && i < len(topCandidates); i++ {
+		result = append(result, topCandidates[i].Track)
+	}
+
+	return result
+}
+
+// deduplicateByID removes duplicate tracks, keeping the first occurrence.
+func deduplicateByID(tracks []track.Track) []track.Track {
+	seen := make(map[string]bool)
+	result := make([]track.Track, 0, len(tracks))
+	for _, t := range tracks {
+		if !seen[t.ID] {
+			seen[t.ID] = true
+			result = append(result, t)
+		}
+	}
+	return result
+}
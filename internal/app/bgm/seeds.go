@@ -0,0 +1,66 @@
+package bgm
+
+import (
+	"math"
+	"sort"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// SeedPolicy decides which recent tracks are used as seeds for BGM recommendations.
+type SeedPolicy struct {
+	UserWeight float64 // Weight of listener and admin requests; other tracks weigh 1
+	Decay      float64 // Weight multiplier per track of age (1: no decay)
+}
+
+// SelectSeeds returns up to count seed tracks from recent, ordered by weight.
+// recent lists the session's tracks from the most recent: queued user requests
+// first (they show where the session is heading), then the current track and
+// the played tracks. Requests weigh more than BGM so that BGM does not feed on
+// itself, and older tracks weigh less. Jingles are never used as seeds.
+func (p SeedPolicy) SelectSeeds(recent []track.QueuedTrack, count int) []track.Track {
+	if count <= 0 {
+		return nil
+	}
+
+	type weightedSeed struct {
+		track  track.Track
+		weight float64
+	}
+
+	seen := make(map[string]bool)
+	weighted := make([]weightedSeed, 0, len(recent))
+	age := 0
+	for _, qt := range recent {
+		if qt.Requester.Type == track.RequesterTypeJingle {
+			continue
+		}
+		if !seen[qt.Track.ID] {
+			seen[qt.Track.ID] = true
+			weighted = append(weighted, weightedSeed{
+				track:  qt.Track,
+				weight: p.typeWeight(qt.Requester.Type) * math.Pow(p.Decay, float64(age)),
+			})
+		}
+		age++
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].weight > weighted[j].weight
+	})
+
+	seeds := make([]track.Track, 0, min(count, len(weighted)))
+	for i := 0; i < count && i < len(weighted); i++ {
+		seeds = append(seeds, weighted[i].track)
+	}
+	return seeds
+}
+
+func (p SeedPolicy) typeWeight(t track.RequesterType) float64 {
+	switch t {
+	case track.RequesterTypeUser, track.RequesterTypeAdmin:
+		return p.UserWeight
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,86 @@
+package bgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func recentTrack(id string, requesterType track.RequesterType) track.QueuedTrack {
+	return track.QueuedTrack{
+		Track:     track.Track{ID: id},
+		Requester: track.Requester{Type: requesterType},
+	}
+}
+
+func TestSeedPolicy_SelectSeeds(t *testing.T) {
+	// Most recent first: a queued request, the current BGM track, then the played tracks
+	recent := []track.QueuedTrack{
+		recentTrack("u1", track.RequesterTypeUser),
+		recentTrack("b1", track.RequesterTypeBGM),
+		recentTrack("j1", track.RequesterTypeJingle),
+		recentTrack("b2", track.RequesterTypeBGM),
+		recentTrack("a1", track.RequesterTypeAdmin),
+		recentTrack("b1", track.RequesterTypeBGM),
+	}
+
+	tests := []struct {
+		name   string
+		policy SeedPolicy
+		count  int
+		want   []string
+	}{
+		{
+			name:   "recency only",
+			policy: SeedPolicy{UserWeight: 1, Decay: 1},
+			count:  3,
+			want:   []string{"u1", "b1", "b2"},
+		},
+		{
+			name:   "requests weigh more",
+			policy: SeedPolicy{UserWeight: 2, Decay: 1},
+			count:  3,
+			want:   []string{"u1", "a1", "b1"},
+		},
+		{
+			name:   "older tracks decay",
+			policy: SeedPolicy{UserWeight: 2, Decay: 0.5},
+			count:  4,
+			// Weights: u1 2, b1 0.5, b2 0.25, a1 0.25
+			want: []string{"u1", "b1", "b2", "a1"},
+		},
+		{
+			name:   "requests outweigh decay",
+			policy: SeedPolicy{UserWeight: 10, Decay: 0.5},
+			count:  2,
+			// Weights: u1 10, b1 0.5, b2 0.25, a1 1.25
+			want: []string{"u1", "a1"},
+		},
+		{
+			name:   "fewer tracks than count",
+			policy: SeedPolicy{UserWeight: 1, Decay: 1},
+			count:  10,
+			want:   []string{"u1", "b1", "b2", "a1"},
+		},
+		{
+			name:   "no seeds",
+			policy: SeedPolicy{UserWeight: 1, Decay: 1},
+			count:  0,
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seeds := tt.policy.SelectSeeds(recent, tt.count)
+
+			var got []string
+			for _, s := range seeds {
+				got = append(got, s.ID)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package bgm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/lastfm"
+	"github.com/osa030/19box/internal/infra/library"
+	spotifyapi "github.com/osa030/19box/internal/infra/spotify"
+)
+
+// SharedClients holds the external API clients and lookup caches shared by the
+// BGM providers of every room, so that rooms do not repeat the same API calls.
+type SharedClients struct {
+	spotify SpotifyClient
+	library *library.Library // nil: no library
+
+	// Last.fm clients keyed by API key
+	lastfmMu sync.Mutex
+	lastfm   map[string]*lastfm.Client
+
+	// Cache for Spotify search results (nil entries record failed searches)
+	searchMu    sync.RWMutex
+	searchCache map[string]*track.Track
+
+	// Caches for artist lookups, keyed by artist ID (nil entries record failed lookups)
+	artistMu       sync.RWMutex
+	relatedCache   map[string][]spotifyapi.Artist
+	topTracksCache map[string][]track.Track
+
+	// Cache for audio attributes, keyed by track ID (nil entries record tracks without attributes)
+	attributesMu    sync.RWMutex
+	attributesCache map[string]*track.AudioAttributes
+}
+
+// NewSharedClients creates a new SharedClients.
+// lib may be nil if no library is configured.
+func NewSharedClients(spotify SpotifyClient, lib *library.Library) *SharedClients {
+	return &SharedClients{
+		spotify:        spotify,
+		library:        lib,
+		lastfm:         make(map[string]*lastfm.Client),
+		searchCache:    make(map[string]*track.Track),
+		relatedCache:   make(map[string][]spotifyapi.Artist),
+		topTracksCache: make(map[string][]track.Track),
+
+		attributesCache: make(map[string]*track.AudioAttributes),
+	}
+}
+
+// Spotify returns the shared Spotify client.
+func (s *SharedClients) Spotify() SpotifyClient {
+	return s.spotify
+}
+
+// Library returns the library of past sessions, or nil if none is configured.
+func (s *SharedClients) Library() *library.Library {
+	return s.library
+}
+
+// LastFm returns the shared Last.fm client for the API key, creating it on first use.
+func (s *SharedClients) LastFm(apiKey string) (*lastfm.Client, error) {
+	s.lastfmMu.Lock()
+	defer s.lastfmMu.Unlock()
+
+	if client, ok := s.lastfm[apiKey]; ok {
+		return client, nil
+	}
+
+	client, err := lastfm.New(lastfm.Config{APIKey: apiKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create last.fm client")
+	}
+	s.lastfm[apiKey] = client
+	return client, nil
+}
+
+// SearchTrack searches for a track on Spotify by name and artist with caching.
+// Returns nil if the track is not found.
+func (s *SharedClients) SearchTrack(ctx context.Context, trackName, artistName string) *track.Track {
+	key := fmt.Sprintf("%s:%s", trackName, artistName)
+
+	// Check cache
+	s.searchMu.RLock()
+	if cached, ok := s.searchCache[key]; ok {
+		s.searchMu.RUnlock()
+		return cached
+	}
+	s.searchMu.RUnlock()
+
+	// Search on Spotify to get track ID
+	query := fmt.Sprintf("track:%s artist:%s", trackName, artistName)
+	results, err := s.spotify.Search(ctx, query, "track", 1)
+	if err != nil || len(results) == 0 {
+		// Cache nil to avoid repeated failed searches
+		s.cacheSearchResult(key, nil)
+		return nil
+	}
+
+	// Get full track information including AvailableMarkets
+	// Search API returns SimpleTrack without market information,
+	// so we need to call GetTrack to get FullTrack with Markets
+	fullTrack, err := s.spotify.GetTrack(ctx, results[0].ID)
+	if err != nil {
+		s.cacheSearchResult(key, nil)
+		return nil
+	}
+
+	s.cacheSearchResult(key, fullTrack)
+	return fullTrack
+}
+
+// RelatedArtists returns the artists related to an artist, most similar first, with caching.
+// Returns nil if the lookup fails.
+func (s *SharedClients) RelatedArtists(ctx context.Context, artistID string) []spotifyapi.Artist {
+	s.artistMu.RLock()
+	cached, ok := s.relatedCache[artistID]
+	s.artistMu.RUnlock()
+	if ok {
+		return cached
+	}
+
+	artists, err := s.spotify.GetRelatedArtists(ctx, artistID)
+	if err != nil {
+		artists = nil
+	}
+
+	s.artistMu.Lock()
+	s.relatedCache[artistID] = artists
+	s.artistMu.Unlock()
+	return artists
+}
+
+// ArtistTopTracks returns an artist's top tracks with caching.
+// Returns nil if the lookup fails.
+func (s *SharedClients) ArtistTopTracks(ctx context.Context, artistID string) []track.Track {
+	s.artistMu.RLock()
+	cached, ok := s.topTracksCache[artistID]
+	s.artistMu.RUnlock()
+	if ok {
+		return cached
+	}
+
+	tracks, err := s.spotify.GetArtistTopTracks(ctx, artistID)
+	if err != nil {
+		tracks = nil
+	}
+
+	s.artistMu.Lock()
+	s.topTracksCache[artistID] = tracks
+	s.artistMu.Unlock()
+	return tracks
+}
+
+// AudioAttributes returns the Spotify audio attributes of tracks, keyed by track
+// ID, with caching. Only the tracks not cached yet are looked up.
+// Tracks without attributes are left out.
+func (s *SharedClients) AudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error) {
+	result := make(map[string]track.AudioAttributes, len(trackIDs))
+	var missing []string
+
+	s.attributesMu.RLock()
+	for _, id := range trackIDs {
+		cached, ok := s.attributesCache[id]
+		switch {
+		case !ok:
+			missing = append(missing, id)
+		case cached != nil:
+			result[id] = *cached
+		}
+	}
+	s.attributesMu.RUnlock()
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	fetched, err := s.spotify.GetAudioAttributes(ctx, missing)
+	if err != nil {
+		return result, err
+	}
+
+	s.attributesMu.Lock()
+	defer s.attributesMu.Unlock()
+	for _, id := range missing {
+		a, ok := fetched[id]
+		if !ok {
+			s.attributesCache[id] = nil
+			continue
+		}
+		s.attributesCache[id] = &a
+		result[id] = a
+	}
+	return result, nil
+}
+
+func (s *SharedClients) cacheSearchResult(key string, t *track.Track) {
+	s.searchMu.Lock()
+	s.searchCache[key] = t
+	s.searchMu.Unlock()
+}
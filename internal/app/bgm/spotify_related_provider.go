@@ -0,0 +1,185 @@
+package bgm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/creasty/defaults"
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+type SpotifyRelatedProviderConfig struct {
+	SeedTrackCount     int     `yaml:"seed_track_count" mapstructure:"seed_track_count" default:"3" validate:"gte=1"`
+	RelatedArtistCount int     `yaml:"related_artist_count" mapstructure:"related_artist_count" default:"5" validate:"gte=1,lte=20"`
+	TopTrackCount      int     `yaml:"top_track_count" mapstructure:"top_track_count" default:"5" validate:"gte=1,lte=10"`
+	RelatedWeight      float64 `yaml:"related_weight" mapstructure:"related_weight" default:"0.6" validate:"lte=1.0"`
+	SeedArtistWeight   float64 `yaml:"seed_artist_weight" mapstructure:"seed_artist_weight" default:"0.4" validate:"lte=1.0"`
+}
+
+// SpotifyRelatedProvider provides BGM tracks using only the Spotify API.
+// Combines the top tracks of artists related to the seed artists with the top
+// tracks of the seed artists themselves, scored like LastFmProvider.
+type SpotifyRelatedProvider struct {
+	shared *SharedClients
+
+	// Configuration
+	candidateCount int
+	config         *SpotifyRelatedProviderConfig
+}
+
+// NewSpotifyRelatedProvider creates a new SpotifyRelatedProvider.
+// Artist lookups are cached in the shared clients.
+func NewSpotifyRelatedProvider(shared *SharedClients, candidateCount int, settings map[string]any) (*SpotifyRelatedProvider, error) {
+	if shared == nil || shared.Spotify() == nil {
+		return nil, errors.New("spotify client is required")
+	}
+
+	var config SpotifyRelatedProviderConfig
+	if err := mapstructure.Decode(settings, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to decode settings")
+	}
+	if err := defaults.Set(&config); err != nil {
+		return nil, errors.Wrap(err, "failed to set defaults")
+	}
+	if err := validator.New().Struct(config); err != nil {
+		return nil, errors.Wrap(err, "validation failed")
+	}
+	if config.RelatedWeight+config.SeedArtistWeight != 1.0 {
+		return nil, errors.New("related weight and seed artist weight must sum to 1.0")
+	}
+
+	return &SpotifyRelatedProvider{
+		shared:         shared,
+		candidateCount: candidateCount,
+		config:         &config,
+	}, nil
+}
+
+// GetCandidates retrieves BGM track candidates from the seed tracks' artists.
+// Without seed tracks there is nothing to relate to, so no candidates are returned.
+func (p *SpotifyRelatedProvider) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, existingTrackIDs map[string]bool) ([]track.Track, error) {
+	if count <= 0 {
+		return []track.Track{}, nil
+	}
+
+	// Limit seed tracks
+	if len(seedTracks) > p.config.SeedTrackCount {
+		seedTracks = seedTracks[:p.config.SeedTrackCount]
+	}
+
+	seedArtistIDs := seedArtists(seedTracks)
+	if len(seedArtistIDs) == 0 {
+		return []track.Track{}, nil
+	}
+
+	// 1. Get related-artist candidates
+	relatedCandidates := p.getRelatedArtistCandidates(ctx, seedArtistIDs, existingTrackIDs)
+
+	// 2. Get seed-artist candidates
+	seedCandidates := p.getTopTracks(ctx, seedArtistIDs, existingTrackIDs)
+
+	// 3. Score and merge
+	scored := mergeScores(relatedCandidates, p.config.RelatedWeight, seedCandidates, p.config.SeedArtistWeight)
+
+	if len(scored) == 0 {
+		return []track.Track{}, nil
+	}
+
+	// 4. Return random selection from top N*2 candidates to add variety
+	return pickVaried(scored, count), nil
+}
+
+// SeedCount returns the number of seed tracks the provider uses.
+// Implements SeedCounter.
+func (p *SpotifyRelatedProvider) SeedCount() int {
+	return p.config.SeedTrackCount
+}
+
+// Name returns the provider name.
+func (p *SpotifyRelatedProvider) Name() string {
+	return "spotify_related"
+}
+
+// getRelatedArtistCandidates retrieves the top tracks of the artists related to the seed artists.
+func (p *SpotifyRelatedProvider) getRelatedArtistCandidates(ctx context.Context, seedArtistIDs []string, existingTrackIDs map[string]bool) []track.Track {
+	var relatedIDs []string
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, artistID := range seedArtistIDs {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			related := p.shared.RelatedArtists(ctx, id)
+			if len(related) > p.config.RelatedArtistCount {
+				related = related[:p.config.RelatedArtistCount]
+			}
+
+			mu.Lock()
+			for _, a := range related {
+				relatedIDs = append(relatedIDs, a.ID)
+			}
+			mu.Unlock()
+		}(artistID)
+	}
+	wg.Wait()
+
+	return p.getTopTracks(ctx, dedupeStrings(relatedIDs), existingTrackIDs)
+}
+
+// getTopTracks retrieves up to top_track_count top tracks of each artist.
+func (p *SpotifyRelatedProvider) getTopTracks(ctx context.Context, artistIDs []string, existingTrackIDs map[string]bool) []track.Track {
+	var candidates []track.Track
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, artistID := range artistIDs {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			tracks := p.shared.ArtistTopTracks(ctx, id)
+			if len(tracks) > p.config.TopTrackCount {
+				tracks = tracks[:p.config.TopTrackCount]
+			}
+
+			mu.Lock()
+			for _, t := range tracks {
+				if !existingTrackIDs[t.ID] {
+					candidates = append(candidates, t)
+				}
+			}
+			mu.Unlock()
+		}(artistID)
+	}
+	wg.Wait()
+
+	return deduplicateByID(candidates)
+}
+
+// seedArtists returns the IDs of the main artists of the seed tracks.
+func seedArtists(seedTracks []track.Track) []string {
+	ids := make([]string, 0, len(seedTracks))
+	for _, seed := range seedTracks {
+		if len(seed.ArtistIDs) > 0 {
+			ids = append(ids, seed.ArtistIDs[0])
+		}
+	}
+	return dedupeStrings(ids)
+}
+
+// dedupeStrings removes duplicate strings, keeping the first occurrence.
+func dedupeStrings(values []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
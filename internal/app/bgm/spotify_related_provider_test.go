@@ -0,0 +1,221 @@
+package bgm
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+	spotifyapi "github.com/osa030/19box/internal/infra/spotify"
+)
+
+// fakeSpotify answers the BGM providers' Spotify lookups from fixed data.
+// Lookups of unknown keys fail. Searches and playlist reads are recorded.
+type fakeSpotify struct {
+	searches  map[string][]track.Track // Track search results by query
+	playlists map[string][]track.Track // Playlist tracks by playlist ID
+	found     map[string][]string      // Playlist search results by query
+	related   map[string][]spotifyapi.Artist
+	topTracks map[string][]track.Track
+	artists   map[string]spotifyapi.Artist
+	tracks    map[string]track.Track // Tracks by ID
+
+	mu    sync.Mutex
+	calls []string // e.g. "search:jazz", "playlists:jazz", "playlist:<ID>"
+}
+
+var errNotFound = errors.New("not found")
+
+func (f *fakeSpotify) record(call string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+}
+
+// takeCalls returns the calls recorded since the last time and forgets them.
+func (f *fakeSpotify) takeCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	calls := f.calls
+	f.calls = nil
+	return calls
+}
+
+func (f *fakeSpotify) GetPlaylistTracksRandom(ctx context.Context, playlistURL string, count int) ([]track.Track, error) {
+	f.record("playlist:" + playlistURL)
+	tracks, ok := f.playlists[playlistURL]
+	if !ok {
+		return nil, errNotFound
+	}
+	return tracks[:min(count, len(tracks))], nil
+}
+
+func (f *fakeSpotify) Search(ctx context.Context, query string, searchType string, limit int) ([]track.Track, error) {
+	f.record("search:" + query)
+	tracks, ok := f.searches[query]
+	if !ok {
+		return nil, errNotFound
+	}
+	return tracks[:min(limit, len(tracks))], nil
+}
+
+func (f *fakeSpotify) SearchPlaylists(ctx context.Context, query string, limit int) ([]string, error) {
+	f.record("playlists:" + query)
+	ids, ok := f.found[query]
+	if !ok {
+		return nil, errNotFound
+	}
+	return ids, nil
+}
+
+func (f *fakeSpotify) GetTrack(ctx context.Context, trackID string, market ...string) (*track.Track, error) {
+	t, ok := f.tracks[trackID]
+	if !ok {
+		return nil, errNotFound
+	}
+	return &t, nil
+}
+
+func (f *fakeSpotify) GetRelatedArtists(ctx context.Context, artistID string) ([]spotifyapi.Artist, error) {
+	artists, ok := f.related[artistID]
+	if !ok {
+		return nil, errNotFound
+	}
+	return artists, nil
+}
+
+func (f *fakeSpotify) GetArtists(ctx context.Context, artistIDs []string) ([]spotifyapi.Artist, error) {
+	var artists []spotifyapi.Artist
+	for _, id := range artistIDs {
+		if a, ok := f.artists[id]; ok {
+			artists = append(artists, a)
+		}
+	}
+	return artists, nil
+}
+
+func (f *fakeSpotify) GetArtistTopTracks(ctx context.Context, artistID string) ([]track.Track, error) {
+	tracks, ok := f.topTracks[artistID]
+	if !ok {
+		return nil, errNotFound
+	}
+	return tracks, nil
+}
+
+func (f *fakeSpotify) GetAudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error) {
+	return map[string]track.AudioAttributes{}, nil
+}
+
+func tracksOf(ids ...string) []track.Track {
+	tracks := make([]track.Track, len(ids))
+	for i, id := range ids {
+		tracks[i] = track.Track{ID: id}
+	}
+	return tracks
+}
+
+func trackIDs(tracks []track.Track) []string {
+	ids := make([]string, len(tracks))
+	for i, t := range tracks {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestSpotifyRelatedProvider_GetCandidates(t *testing.T) {
+	client := &fakeSpotify{
+		related: map[string][]spotifyapi.Artist{
+			"seed1": {{ID: "rel1"}, {ID: "rel2"}, {ID: "rel3"}},
+			"seed2": {{ID: "rel1"}},
+		},
+		topTracks: map[string][]track.Track{
+			"seed1": tracksOf("s1a", "s1b", "s1c"),
+			"seed2": tracksOf("s2a"),
+			"seed3": tracksOf("s3a"),
+			"rel1":  tracksOf("r1a", "r1b", "s1a"),
+			"rel2":  tracksOf("r2a"),
+			"rel3":  tracksOf("r3a"),
+		},
+	}
+	seeds := []track.Track{
+		{ID: "t1", ArtistIDs: []string{"seed1"}},
+		{ID: "t2", ArtistIDs: []string{"seed2", "feat"}},
+		{ID: "t3", ArtistIDs: []string{"seed3"}},
+	}
+
+	tests := []struct {
+		name     string
+		settings map[string]any
+		seeds    []track.Track
+		existing map[string]bool
+		want     []string
+	}{
+		{
+			name:     "related and seed artists' top tracks",
+			settings: map[string]any{"seed_track_count": 2, "related_artist_count": 2, "top_track_count": 2},
+			seeds:    seeds,
+			// rel3 is past related_artist_count, seed3 past seed_track_count,
+			// and top tracks past top_track_count are left out
+			want: []string{"r1a", "r1b", "r2a", "s1a", "s1b", "s2a"},
+		},
+		{
+			name:     "existing tracks are excluded",
+			settings: map[string]any{"seed_track_count": 1, "related_artist_count": 1, "top_track_count": 3},
+			seeds:    seeds,
+			existing: map[string]bool{"s1a": true, "r1b": true},
+			want:     []string{"r1a", "s1b", "s1c"},
+		},
+		{
+			name:     "no seeds",
+			settings: map[string]any{},
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewSpotifyRelatedProvider(NewSharedClients(client, nil), 10, tt.settings)
+			require.NoError(t, err)
+
+			got, err := p.GetCandidates(context.Background(), 10, tt.seeds, tt.existing)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, trackIDs(got))
+		})
+	}
+}
+
+func TestSpotifyRelatedProvider_GetCandidates_PrefersHigherScores(t *testing.T) {
+	client := &fakeSpotify{
+		related:   map[string][]spotifyapi.Artist{"seed": {{ID: "rel"}}},
+		topTracks: map[string][]track.Track{"seed": tracksOf("both", "seed_only"), "rel": tracksOf("both", "rel_only")},
+	}
+	p, err := NewSpotifyRelatedProvider(NewSharedClients(client, nil), 10, map[string]any{})
+	require.NoError(t, err)
+
+	// Scores: both 1.0, rel_only 0.6 (related_weight), seed_only 0.4 (seed_artist_weight).
+	// One candidate is picked from the best two.
+	seeds := []track.Track{{ID: "t", ArtistIDs: []string{"seed"}}}
+	for range 20 {
+		got, err := p.GetCandidates(context.Background(), 1, seeds, nil)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Contains(t, []string{"both", "rel_only"}, got[0].ID)
+	}
+}
+
+func TestNewSpotifyRelatedProvider_Validation(t *testing.T) {
+	shared := NewSharedClients(&fakeSpotify{}, nil)
+
+	_, err := NewSpotifyRelatedProvider(shared, 10, map[string]any{"related_weight": 0.5, "seed_artist_weight": 0.3})
+	assert.Error(t, err, "weights must sum to 1")
+
+	_, err = NewSpotifyRelatedProvider(shared, 10, map[string]any{"top_track_count": 11})
+	assert.Error(t, err)
+
+	_, err = NewSpotifyRelatedProvider(NewSharedClients(nil, nil), 10, map[string]any{})
+	assert.Error(t, err, "a spotify client is required")
+}
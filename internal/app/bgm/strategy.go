@@ -0,0 +1,81 @@
+package bgm
+
+import (
+	"math/rand/v2"
+)
+
+// Strategy decides which providers of a chain are asked for candidates, and in which order.
+type Strategy string
+
+const (
+	// StrategyAll asks every provider, in order, to maximize the candidate pool.
+	StrategyAll Strategy = "all"
+	// StrategyFallback asks providers in order until enough candidates are found.
+	StrategyFallback Strategy = "fallback"
+	// StrategyWeighted picks providers at random by weight until enough candidates are found.
+	StrategyWeighted Strategy = "weighted"
+	// StrategyRoundRobin starts with the next provider on each call, until enough candidates are found.
+	StrategyRoundRobin Strategy = "round_robin"
+)
+
+// stopsWhenEnough reports whether the strategy stops asking providers once enough candidates are found.
+func (s Strategy) stopsWhenEnough() bool {
+	return s != StrategyAll && s != ""
+}
+
+// providerOrder returns the providers in the order the chain asks them on this call.
+func (c *ProviderChain) providerOrder() []ProviderWithMetadata {
+	switch c.strategy {
+	case StrategyRoundRobin:
+		c.mu.Lock()
+		start := c.nextProvider % len(c.providers)
+		c.nextProvider++
+		c.mu.Unlock()
+
+		order := make([]ProviderWithMetadata, 0, len(c.providers))
+		order = append(order, c.providers[start:]...)
+		return append(order, c.providers[:start]...)
+
+	case StrategyWeighted:
+		random := c.random
+		if random == nil {
+			random = rand.Float64
+		}
+		return weightedOrder(c.providers, random)
+
+	default:
+		return c.providers
+	}
+}
+
+// weightedOrder draws the providers one by one without replacement, each with a
+// probability proportional to its weight. Providers without weight come last.
+// random returns numbers in [0, 1).
+func weightedOrder(providers []ProviderWithMetadata, random func() float64) []ProviderWithMetadata {
+	remaining := make([]ProviderWithMetadata, len(providers))
+	copy(remaining, providers)
+
+	order := make([]ProviderWithMetadata, 0, len(providers))
+	for len(remaining) > 0 {
+		var total float64
+		for _, pm := range remaining {
+			total += max(pm.Weight, 0)
+		}
+		if total <= 0 {
+			return append(order, remaining...)
+		}
+
+		pick := len(remaining) - 1
+		r := random() * total
+		for i, pm := range remaining {
+			r -= max(pm.Weight, 0)
+			if r < 0 {
+				pick = i
+				break
+			}
+		}
+		order = append(order, remaining[pick])
+		remaining = append(remaining[:pick], remaining[pick+1:]...)
+	}
+	return order
+}
@@ -0,0 +1,96 @@
+package bgm
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func weighted(weights ...float64) []ProviderWithMetadata {
+	providers := make([]ProviderWithMetadata, len(weights))
+	for i, w := range weights {
+		providers[i] = ProviderWithMetadata{DisplayName: string(rune('a' + i)), Weight: w}
+	}
+	return providers
+}
+
+func namesOf(providers []ProviderWithMetadata) []string {
+	names := make([]string, len(providers))
+	for i, pm := range providers {
+		names[i] = pm.DisplayName
+	}
+	return names
+}
+
+// sequence returns a source that yields the given numbers in turn.
+func sequence(numbers ...float64) func() float64 {
+	return func() float64 {
+		n := numbers[0]
+		numbers = numbers[1:]
+		return n
+	}
+}
+
+func TestWeightedOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float64
+		draws   []float64
+		want    []string
+	}{
+		{name: "low draw picks the first", weights: []float64{1, 3}, draws: []float64{0.2, 0}, want: []string{"a", "b"}},
+		{name: "high draw picks the heavier", weights: []float64{1, 3}, draws: []float64{0.3, 0}, want: []string{"b", "a"}},
+		{name: "weights are renormalized after each draw", weights: []float64{1, 1, 2}, draws: []float64{0.9, 0.6, 0}, want: []string{"c", "b", "a"}},
+		{name: "providers without weight come last", weights: []float64{0, 2, -1, 1}, draws: []float64{0.7, 0}, want: []string{"d", "b", "a", "c"}},
+		{name: "no weights keeps the configured order", weights: []float64{0, 0}, want: []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := weightedOrder(weighted(tt.weights...), sequence(tt.draws...))
+			assert.Equal(t, tt.want, namesOf(got))
+		})
+	}
+}
+
+func TestWeightedOrder_Distribution(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	providers := weighted(3, 1)
+
+	first := make(map[string]int)
+	const draws = 10000
+	for range draws {
+		order := weightedOrder(providers, rng.Float64)
+		assert.Len(t, order, 2)
+		first[order[0].DisplayName]++
+	}
+
+	// a is three times as likely to be picked first as b
+	assert.InDelta(t, 0.75, float64(first["a"])/draws, 0.02)
+	assert.Equal(t, []string{"a", "b"}, namesOf(providers), "the configured order is left untouched")
+}
+
+func TestProviderChain_ProviderOrder(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		c := NewProviderChain(weighted(1, 1, 1), StrategyRoundRobin, 0)
+		assert.Equal(t, []string{"a", "b", "c"}, namesOf(c.providerOrder()))
+		assert.Equal(t, []string{"b", "c", "a"}, namesOf(c.providerOrder()))
+		assert.Equal(t, []string{"c", "a", "b"}, namesOf(c.providerOrder()))
+		assert.Equal(t, []string{"a", "b", "c"}, namesOf(c.providerOrder()))
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		c := NewProviderChain(weighted(1, 1), StrategyWeighted, 0)
+		c.random = sequence(0.6, 0)
+		assert.Equal(t, []string{"b", "a"}, namesOf(c.providerOrder()))
+	})
+
+	for _, strategy := range []Strategy{"", StrategyAll, StrategyFallback} {
+		t.Run("in order: "+string(strategy), func(t *testing.T) {
+			c := NewProviderChain(weighted(1, 1, 1), strategy, 0)
+			assert.Equal(t, []string{"a", "b", "c"}, namesOf(c.providerOrder()))
+			assert.Equal(t, []string{"a", "b", "c"}, namesOf(c.providerOrder()))
+		})
+	}
+}
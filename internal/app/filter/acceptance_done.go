@@ -16,6 +16,8 @@ type AcceptanceDoneFilter struct {
 	getQueueDuration func() time.Duration
 	getCurrentRemain func() time.Duration
 	getNow           func() time.Time
+	trackSlot        func(time.Duration) time.Duration // nil: only the track's start is checked
+	deferTimeLimit   bool                              // Check leaves the time limit to Admit
 }
 
 // NewAcceptanceDoneFilter creates a new AcceptanceDoneFilter.
@@ -37,6 +39,20 @@ func NewAcceptanceDoneFilter(
 	}
 }
 
+// RejectOverrun makes the filter also reject tracks that would still be playing
+// at the deadline, not only those that would start after it. slot returns the
+// time a track of the given duration holds the timeline.
+func (f *AcceptanceDoneFilter) RejectOverrun(slot func(time.Duration) time.Duration) {
+	f.trackSlot = slot
+}
+
+// DeferTimeLimit makes Check only reject requests once the session stops
+// accepting them. The time limit is left to Admit, for callers that queue
+// requests ahead of other tracks: the whole queue would overstate their start.
+func (f *AcceptanceDoneFilter) DeferTimeLimit() {
+	f.deferTimeLimit = true
+}
+
 func (f *AcceptanceDoneFilter) Name() string {
 	return "acceptance_done_filter"
 }
@@ -64,7 +80,18 @@ func (f *AcceptanceDoneFilter) Check(ctx context.Context, req TrackRequest, t tr
 	if !f.isAccepting() {
 		return Reject("acceptance_done")
 	}
+	if f.deferTimeLimit {
+		return Accept()
+	}
 
+	return f.Admit(f.getCurrentRemain()+f.getQueueDuration(), t)
+}
+
+// Admit checks the time limit for a track that would start after startsIn
+// (the current track's remaining time plus the queued tracks).
+// The session manager calls it again while enqueueing, so that concurrent
+// requests cannot all take the last free time before the deadline.
+func (f *AcceptanceDoneFilter) Admit(startsIn time.Duration, t track.Track) Result {
 	// Check time limit if end time is set
 	endTime := f.getEndTime()
 	if endTime != nil {
@@ -81,15 +108,19 @@ func (f *AcceptanceDoneFilter) Check(ctx context.Context, req TrackRequest, t tr
 		// Second check: calculate playback start time:
 		// current time + current track remaining + queue duration
 		// If the playback start time is at or after the deadline, reject the request
-		// Note: This may allow the track to play beyond the deadline, but prevents
-		// creating gaps in playback before the ending playlist
-		currentRemaining := f.getCurrentRemain()
-		queueDuration := f.getQueueDuration()
-		playbackStartTime := now.Add(currentRemaining).Add(queueDuration)
+		// Note: Unless overruns are rejected, this may allow the track to play beyond
+		// the deadline, but prevents creating gaps in playback before the ending playlist
+		playbackStartTime := now.Add(startsIn)
 
 		if playbackStartTime.After(deadline) || playbackStartTime.Equal(deadline) {
 			return Reject("time_limit_exceeded")
 		}
+
+		// Third check: the track must end by the deadline, so that the ending
+		// playlist still finishes by the end time
+		if f.trackSlot != nil && playbackStartTime.Add(f.trackSlot(t.Duration)).After(deadline) {
+			return Reject("time_limit_exceeded")
+		}
 	}
 
 	return Accept()
@@ -10,6 +10,57 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestAcceptanceDoneFilter_Admit(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(1 * time.Hour)
+
+	filter := NewAcceptanceDoneFilter(
+		func() bool { return true },
+		func() *time.Time { return &endTime },
+		func() time.Duration { return 5 * time.Minute },
+		func() time.Duration { return 0 },
+		func() time.Duration { return 0 },
+		func() time.Time { return now },
+	)
+	trk := track.Track{ID: "test-track", Duration: 3 * time.Minute}
+
+	// The start offset passed in is used instead of the queue seen by Check
+	assert.True(t, filter.Admit(50*time.Minute, trk).Accepted)
+	result := filter.Admit(55*time.Minute, trk)
+	assert.False(t, result.Accepted)
+	assert.Equal(t, "time_limit_exceeded", result.Code)
+}
+
+func TestAcceptanceDoneFilter_DeferTimeLimit(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(10 * time.Minute)
+	accepting := true
+
+	filter := NewAcceptanceDoneFilter(
+		func() bool { return accepting },
+		func() *time.Time { return &endTime },
+		func() time.Duration { return 5 * time.Minute },
+		func() time.Duration { return 30 * time.Minute },
+		func() time.Duration { return 0 },
+		func() time.Time { return now },
+	)
+	filter.DeferTimeLimit()
+	trk := track.Track{ID: "test-track", Duration: 3 * time.Minute}
+	req := TrackRequest{TrackID: "test-track"}
+	lis := &listener.Session{ID: "test-listener"}
+
+	// The queue runs past the deadline, but Admit decides with the actual start
+	assert.True(t, filter.Check(context.Background(), req, trk, lis).Accepted)
+	assert.True(t, filter.Admit(time.Minute, trk).Accepted)
+	assert.False(t, filter.Admit(30*time.Minute, trk).Accepted)
+
+	// Requests are still rejected once the session stops accepting them
+	accepting = false
+	result := filter.Check(context.Background(), req, trk, lis)
+	assert.False(t, result.Accepted)
+	assert.Equal(t, "acceptance_done", result.Code)
+}
+
 func TestAcceptanceDoneFilter_Check(t *testing.T) {
 	now := time.Now()
 	endTime := now.Add(1 * time.Hour)
@@ -23,6 +74,7 @@ func TestAcceptanceDoneFilter_Check(t *testing.T) {
 		queueDuration    time.Duration
 		currentRemaining time.Duration
 		trackDuration    time.Duration
+		rejectOverrun    bool
 		wantAccepted     bool
 		wantCode         string
 	}{
@@ -93,6 +145,31 @@ func TestAcceptanceDoneFilter_Check(t *testing.T) {
 			// Start is before deadline -> Accept (allows track to exceed deadline)
 			wantAccepted: true,
 		},
+		{
+			name:             "track end exceeds deadline with overruns rejected",
+			isAccepting:      true,
+			endTime:          &endTime,
+			endingDuration:   endingDuration,
+			queueDuration:    40 * time.Minute,
+			currentRemaining: 10 * time.Minute,
+			trackDuration:    10 * time.Minute,
+			rejectOverrun:    true,
+			// Track end: 50 + 10 = 60 mins from now
+			// 60 > 55 -> Reject
+			wantAccepted: false,
+			wantCode:     "time_limit_exceeded",
+		},
+		{
+			name:             "track ends exactly at deadline with overruns rejected",
+			isAccepting:      true,
+			endTime:          &endTime,
+			endingDuration:   endingDuration,
+			queueDuration:    40 * time.Minute,
+			currentRemaining: 10 * time.Minute,
+			trackDuration:    5 * time.Minute,
+			rejectOverrun:    true,
+			wantAccepted:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -105,6 +182,9 @@ func TestAcceptanceDoneFilter_Check(t *testing.T) {
 				func() time.Duration { return tt.currentRemaining },
 				func() time.Time { return now },
 			)
+			if tt.rejectOverrun {
+				filter.RejectOverrun(func(d time.Duration) time.Duration { return d })
+			}
 
 			trk := track.Track{
 				ID:       "test-track",
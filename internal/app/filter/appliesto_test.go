@@ -112,3 +112,27 @@ func TestAcceptanceDoneFilter_AppliesTo(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestsPausedFilter_AppliesTo(t *testing.T) {
+	filter := NewRequestsPausedFilter(func() bool { return true })
+
+	tests := []struct {
+		name          string
+		requesterType track.RequesterType
+		want          bool
+	}{
+		{"USER", track.RequesterTypeUser, true},
+		{"SYSTEM", track.RequesterTypeSystem, false},
+		{"OPENING", track.RequesterTypeOpening, false},
+		{"ENDING", track.RequesterTypeEnding, false},
+		{"BGM", track.RequesterTypeBGM, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filter.AppliesTo(tt.requesterType)
+			assert.Equal(t, tt.want, result,
+				"RequestsPausedFilter.AppliesTo() should only apply to USER type")
+		})
+	}
+}
@@ -3,11 +3,19 @@ package filter
 
 import (
 	"context"
+	"time"
 
 	"github.com/osa030/19box/internal/domain/listener"
 	"github.com/osa030/19box/internal/domain/track"
 )
 
+// Evaluation represents the outcome of a single filter check within the chain.
+type Evaluation struct {
+	Filter  string        // Filter name
+	Result  Result        // Filter result
+	Latency time.Duration // Time spent in Check
+}
+
 // Chain executes filters in sequence.
 type Chain struct {
 	filters []Filter
@@ -29,18 +37,50 @@ func (c *Chain) Add(f Filter) {
 // Returns immediately if any filter rejects the request.
 // Filters are only applied if they declare they apply to the given requester type.
 func (c *Chain) Execute(ctx context.Context, req TrackRequest, t track.Track, l *listener.Session, requesterType track.RequesterType) Result {
+	result, _ := c.Trace(ctx, req, t, l, requesterType)
+	return result
+}
+
+// Trace runs all filters in sequence like Execute, and additionally returns
+// the evaluation of every filter that was actually checked (in order).
+// The last evaluation is the rejecting one when the result is not accepted.
+func (c *Chain) Trace(ctx context.Context, req TrackRequest, t track.Track, l *listener.Session, requesterType track.RequesterType) (Result, []Evaluation) {
+	evaluations := make([]Evaluation, 0, len(c.filters))
 	for _, f := range c.filters {
 		// Skip filters that don't apply to this requester type
 		if !f.AppliesTo(requesterType) {
 			continue
 		}
 
+		start := time.Now()
 		result := f.Check(ctx, req, t, l)
+		evaluations = append(evaluations, Evaluation{
+			Filter:  f.Name(),
+			Result:  result,
+			Latency: time.Since(start),
+		})
 		if !result.Accepted {
-			return result
+			return result, evaluations
+		}
+	}
+	return Accept(), evaluations
+}
+
+// Without returns a new chain containing the filters of this chain except
+// the named ones. The order of the remaining filters is preserved.
+func (c *Chain) Without(names ...string) *Chain {
+	excluded := make(map[string]bool, len(names))
+	for _, name := range names {
+		excluded[name] = true
+	}
+
+	result := NewChain()
+	for _, f := range c.filters {
+		if !excluded[f.Name()] {
+			result.Add(f)
 		}
 	}
-	return Accept()
+	return result
 }
 
 // Filters returns all filters in the chain.
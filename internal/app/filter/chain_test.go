@@ -0,0 +1,95 @@
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/listener"
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func TestChain_Trace(t *testing.T) {
+	chain := NewChain()
+	chain.Add(&KickedFilter{})
+	chain.Add(NewMarketFilter("JP"))
+	chain.Add(&UserPendingFilter{})
+
+	trk := track.Track{ID: "test-track", Markets: []string{"JP"}}
+	req := TrackRequest{TrackID: "test-track"}
+
+	t.Run("accepted request evaluates all applicable filters", func(t *testing.T) {
+		lis := &listener.Session{ID: "test-listener"}
+		result, evaluations := chain.Trace(context.Background(), req, trk, lis, track.RequesterTypeUser)
+
+		assert.True(t, result.Accepted)
+		require.Len(t, evaluations, 3)
+		assert.Equal(t, "kicked_listener_filter", evaluations[0].Filter)
+		assert.Equal(t, "market_filter", evaluations[1].Filter)
+		assert.Equal(t, "user_pending_filter", evaluations[2].Filter)
+	})
+
+	t.Run("rejection stops the chain", func(t *testing.T) {
+		lis := &listener.Session{ID: "test-listener", IsKicked: true}
+		result, evaluations := chain.Trace(context.Background(), req, trk, lis, track.RequesterTypeUser)
+
+		assert.False(t, result.Accepted)
+		assert.Equal(t, "kicked", result.Code)
+		require.Len(t, evaluations, 1)
+		assert.Equal(t, "kicked", evaluations[0].Result.Code)
+	})
+
+	t.Run("filters not applying to requester type are not evaluated", func(t *testing.T) {
+		lis := &listener.Session{ID: "system"}
+		result, evaluations := chain.Trace(context.Background(), req, trk, lis, track.RequesterTypeBGM)
+
+		assert.True(t, result.Accepted)
+		require.Len(t, evaluations, 1)
+		assert.Equal(t, "market_filter", evaluations[0].Filter)
+	})
+}
+
+func TestChain_Without(t *testing.T) {
+	chain := NewChain()
+	chain.Add(&KickedFilter{})
+	chain.Add(NewMarketFilter("JP"))
+	chain.Add(&UserPendingFilter{})
+
+	reduced := chain.Without("kicked_listener_filter", "unknown_filter")
+
+	require.Len(t, reduced.Filters(), 2)
+	assert.Equal(t, "market_filter", reduced.Filters()[0].Name())
+	assert.Equal(t, "user_pending_filter", reduced.Filters()[1].Name())
+	assert.Len(t, chain.Filters(), 3, "original chain should not be modified")
+
+	lis := &listener.Session{ID: "test-listener", IsKicked: true}
+	trk := track.Track{ID: "test-track", Markets: []string{"JP"}}
+	result := reduced.Execute(context.Background(), TrackRequest{TrackID: "test-track"}, trk, lis, track.RequesterTypeUser)
+	assert.True(t, result.Accepted, "kicked filter should be bypassed")
+}
+
+func TestChain_Conditional(t *testing.T) {
+	active := false
+	chain := NewChain()
+	chain.Add(When(func() bool { return active }, &KickedFilter{}))
+
+	lis := &listener.Session{ID: "test-listener", IsKicked: true}
+	trk := track.Track{ID: "test-track"}
+	req := TrackRequest{TrackID: "test-track"}
+
+	result, evaluations := chain.Trace(context.Background(), req, trk, lis, track.RequesterTypeUser)
+	assert.True(t, result.Accepted, "inactive filter should be skipped")
+	assert.Empty(t, evaluations)
+
+	active = true
+	result, evaluations = chain.Trace(context.Background(), req, trk, lis, track.RequesterTypeUser)
+	assert.False(t, result.Accepted)
+	assert.Equal(t, "kicked", result.Code)
+	require.Len(t, evaluations, 1)
+	assert.Equal(t, "kicked_listener_filter", evaluations[0].Filter)
+
+	result, _ = chain.Trace(context.Background(), req, trk, lis, track.RequesterTypeBGM)
+	assert.True(t, result.Accepted, "wrapped filter's requester types still apply")
+}
@@ -0,0 +1,23 @@
+package filter
+
+import (
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// Conditional applies a filter only while a condition holds, such as during a
+// phase of the session. While the condition does not hold, the filter is
+// skipped as if it did not apply to the requester type.
+type Conditional struct {
+	Filter
+	active func() bool
+}
+
+// When wraps a filter so that it only applies while active returns true.
+func When(active func() bool, f Filter) *Conditional {
+	return &Conditional{Filter: f, active: active}
+}
+
+// AppliesTo returns true if the condition holds and the wrapped filter applies to the requester type.
+func (c *Conditional) AppliesTo(requesterType track.RequesterType) bool {
+	return c.active() && c.Filter.AppliesTo(requesterType)
+}
@@ -68,6 +68,11 @@ func (f *DuplicateTrackFilter) Check(
 	queuedTracks := f.queueManager.GetAllTracks()
 
 	for _, queued := range queuedTracks {
+		// Jingles are station IDs, not part of the program
+		if queued.Requester.Type == track.RequesterTypeJingle {
+			continue
+		}
+
 		// 1. Exact track ID match
 		if queued.Track.ID == requestedTrack.ID {
 			return Result{
@@ -234,6 +234,37 @@ func TestDuplicateTrackFilter_EmptyQueue(t *testing.T) {
 	assert.True(t, result.Accepted, "Should accept any track when queue is empty")
 }
 
+func TestDuplicateTrackFilter_IgnoresJingles(t *testing.T) {
+	qm := &mockQueueManager{
+		tracks: []track.QueuedTrack{
+			{
+				Track: track.Track{
+					ID:      "jingle1",
+					Name:    "Station ID",
+					Artists: []string{"19box"},
+				},
+				Requester: track.Requester{ID: "system", Name: "Station ID", Type: track.RequesterTypeJingle},
+				AddedAt:   time.Now(),
+			},
+		},
+	}
+
+	filter := NewDuplicateTrackFilter(qm)
+
+	result := filter.Check(
+		context.Background(),
+		TrackRequest{},
+		track.Track{
+			ID:      "jingle1",
+			Name:    "Station ID",
+			Artists: []string{"19box"},
+		},
+		&listener.Session{},
+	)
+
+	assert.True(t, result.Accepted, "Should not treat a playing jingle as a duplicate")
+}
+
 func TestDuplicateTrackFilter_AppliesTo(t *testing.T) {
 	filter := NewDuplicateTrackFilter(&mockQueueManager{})
 
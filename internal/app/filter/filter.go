@@ -3,9 +3,13 @@ package filter
 
 import (
 	"context"
+	"sort"
+
+	"github.com/cockroachdb/errors"
 
 	"github.com/osa030/19box/internal/domain/listener"
 	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/config"
 )
 
 // TrackRequest represents a track request to be validated.
@@ -58,3 +62,27 @@ func Register(name string, factory func() Filter) {
 func GetRegistered() map[string]func() Filter {
 	return registry
 }
+
+// ValidateConfigs checks that the enabled filters are registered and that
+// their settings are valid. Used for filters that are only built from the
+// registry, such as the last call filters.
+func ValidateConfigs(filters map[string]config.FilterConfig) error {
+	names := make([]string, 0, len(filters))
+	for name, fc := range filters {
+		if fc.Enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		factory, ok := registry[name]
+		if !ok {
+			return errors.Newf("unknown filter: %s", name)
+		}
+		if err := factory().ValidateConfig(filters[name].Settings); err != nil {
+			return errors.Wrapf(err, "filter %s", name)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/osa030/19box/internal/domain/listener"
 	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/config"
 )
 
 func TestMarketFilter_Check(t *testing.T) {
@@ -171,3 +172,52 @@ func TestKickedFilter_Check(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfigs(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters map[string]config.FilterConfig
+		wantErr string
+	}{
+		{
+			name: "valid settings",
+			filters: map[string]config.FilterConfig{
+				"duration_limit_filter": {Enabled: true, Settings: map[string]any{"max_minutes": 5}},
+			},
+		},
+		{
+			name: "invalid settings",
+			filters: map[string]config.FilterConfig{
+				"duration_limit_filter": {Enabled: true, Settings: map[string]any{"min_minutes": 5, "max_minutes": 3}},
+			},
+			wantErr: "filter duration_limit_filter",
+		},
+		{
+			name: "unknown filter",
+			filters: map[string]config.FilterConfig{
+				"unknown_filter": {Enabled: true},
+			},
+			wantErr: "unknown filter: unknown_filter",
+		},
+		{
+			name: "disabled filters are not checked",
+			filters: map[string]config.FilterConfig{
+				"unknown_filter":        {},
+				"duration_limit_filter": {Settings: map[string]any{"min_minutes": 5, "max_minutes": 3}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfigs(tt.filters)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
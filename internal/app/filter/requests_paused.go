@@ -0,0 +1,46 @@
+package filter
+
+import (
+	"context"
+
+	"github.com/osa030/19box/internal/domain/listener"
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// RequestsPausedFilter rejects user requests while the current segment pauses them.
+type RequestsPausedFilter struct {
+	isPaused func() bool
+}
+
+// NewRequestsPausedFilter creates a new RequestsPausedFilter.
+func NewRequestsPausedFilter(isPaused func() bool) *RequestsPausedFilter {
+	return &RequestsPausedFilter{isPaused: isPaused}
+}
+
+func (f *RequestsPausedFilter) Name() string {
+	return "requests_paused_filter"
+}
+
+func (f *RequestsPausedFilter) Description() string {
+	return "Rejects user requests while a session segment pauses them"
+}
+
+func (f *RequestsPausedFilter) ReturnCodes() []string {
+	return []string{"requests_paused"}
+}
+
+func (f *RequestsPausedFilter) ValidateConfig(settings map[string]any) error {
+	return nil
+}
+
+func (f *RequestsPausedFilter) AppliesTo(requesterType track.RequesterType) bool {
+	// Segments only pause user requests; BGM keeps the queue filled
+	return requesterType == track.RequesterTypeUser
+}
+
+func (f *RequestsPausedFilter) Check(ctx context.Context, req TrackRequest, t track.Track, l *listener.Session) Result {
+	if f.isPaused() {
+		return Reject("requests_paused")
+	}
+	return Accept()
+}
@@ -18,8 +18,9 @@ type Stream interface {
 
 // subscription represents a subscriber's subscription.
 type subscription struct {
-	id     string
-	stream Stream
+	id         string
+	listenerID string // Listener that opened the stream (empty if anonymous)
+	stream     Stream
 }
 
 // Manager manages notification subscriptions and broadcasting.
@@ -38,7 +39,8 @@ func NewManager() *Manager {
 }
 
 // Subscribe adds a new subscription and returns the subscription ID and current sequence number.
-func (m *Manager) Subscribe(stream Stream) (string, uint64) {
+// listenerID is the listener that opened the stream, or empty for an anonymous subscriber.
+func (m *Manager) Subscribe(stream Stream, listenerID string) (string, uint64) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -48,8 +50,9 @@ func (m *Manager) Subscribe(stream Stream) (string, uint64) {
 
 	id := uuid.New().String()
 	m.subscriptions[id] = &subscription{
-		id:     id,
-		stream: stream,
+		id:         id,
+		listenerID: listenerID,
+		stream:     stream,
 	}
 	return id, seq
 }
@@ -82,6 +85,39 @@ func (m *Manager) Broadcast(notification *jukeboxv1.Notification) error {
 	}
 	m.mu.RUnlock()
 
+	sendAll(subs, notification)
+	return nil
+}
+
+// SendToListeners sends a notification to the streams opened by the given listeners.
+// Anonymous subscribers and other listeners do not receive it, so it does not
+// advance the sequence number: it carries the number of the last broadcast.
+func (m *Manager) SendToListeners(listenerIDs []string, notification *jukeboxv1.Notification) error {
+	m.sequenceNoMu.Lock()
+	notification.SequenceNo = m.sequenceNo
+	m.sequenceNoMu.Unlock()
+
+	targets := make(map[string]bool, len(listenerIDs))
+	for _, id := range listenerIDs {
+		targets[id] = true
+	}
+
+	m.mu.RLock()
+	var subs []*subscription
+	for _, sub := range m.subscriptions {
+		if sub.listenerID != "" && targets[sub.listenerID] {
+			subs = append(subs, sub)
+		}
+	}
+	m.mu.RUnlock()
+
+	sendAll(subs, notification)
+	return nil
+}
+
+// sendAll sends a notification to each subscriber in parallel.
+// Each stream send is done in a goroutine with a timeout to prevent blocking.
+func sendAll(subs []*subscription, notification *jukeboxv1.Notification) {
 	// Send to each subscriber in parallel with timeout
 	var wg sync.WaitGroup
 	for _, sub := range subs {
@@ -109,7 +145,6 @@ func (m *Manager) Broadcast(notification *jukeboxv1.Notification) error {
 
 	// Wait for all sends to complete or timeout
 	wg.Wait()
-	return nil
 }
 
 // Send sends a notification to a specific subscriber.
@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jukeboxv1 "github.com/osa030/19box/internal/gen/jukebox/v1"
+)
+
+// recordingStream records the notifications sent to it.
+type recordingStream struct {
+	mu   sync.Mutex
+	sent []*jukeboxv1.Notification
+}
+
+func (s *recordingStream) Send(n *jukeboxv1.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, n)
+	return nil
+}
+
+func (s *recordingStream) notifications() []*jukeboxv1.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sent
+}
+
+func TestManager_SendToListeners(t *testing.T) {
+	m := NewManager()
+	alice, bob, anonymous := &recordingStream{}, &recordingStream{}, &recordingStream{}
+	m.Subscribe(alice, "alice")
+	m.Subscribe(bob, "bob")
+	m.Subscribe(anonymous, "")
+
+	require.NoError(t, m.Broadcast(&jukeboxv1.Notification{Type: jukeboxv1.NotificationType_NOTIFICATION_TYPE_CHANGE_STATE}))
+	require.NoError(t, m.SendToListeners([]string{"alice", "carol"}, &jukeboxv1.Notification{Type: jukeboxv1.NotificationType_NOTIFICATION_TYPE_DRIFT_DETECTED}))
+	require.NoError(t, m.Broadcast(&jukeboxv1.Notification{Type: jukeboxv1.NotificationType_NOTIFICATION_TYPE_CHANGE_STATE}))
+
+	got := alice.notifications()
+	require.Len(t, got, 3)
+	assert.Equal(t, jukeboxv1.NotificationType_NOTIFICATION_TYPE_DRIFT_DETECTED, got[1].Type)
+	assert.Equal(t, uint64(1), got[1].SequenceNo, "a targeted notification carries the last broadcast's sequence number")
+	assert.Equal(t, uint64(2), got[2].SequenceNo, "a targeted notification does not advance the sequence number")
+
+	assert.Len(t, bob.notifications(), 2)
+	assert.Len(t, anonymous.notifications(), 2)
+}
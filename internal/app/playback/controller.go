@@ -16,13 +16,27 @@ var (
 	ErrQueueEmpty = errors.New("queue is empty")
 	ErrNotPlaying = errors.New("not playing")
 	ErrNotPaused  = errors.New("not paused")
+
+	ErrNoPreviousTrack = errors.New("no previous track")
+	ErrInvalidPosition = errors.New("position is out of the track")
 )
 
 // Config holds controller configuration.
 type Config struct {
-	DepletionThresholdSec int           // Threshold for queue depletion warning
-	NotificationDelay     time.Duration // Base delay before emitting EventTrackStarted
-	GapCorrection         time.Duration // Small delay to compensate for client drift
+	DepletionThresholdSec int            // Threshold for queue depletion warning
+	NotificationDelay     time.Duration  // Base delay before emitting EventTrackStarted
+	GapCorrection         time.Duration  // Small delay to compensate for client drift (not used when drift is corrected)
+	Timing                Timing         // Crossfade and per-track offsets
+	Jingles               JingleSchedule // When to interleave jingles (see SetJingles)
+
+	// Device control (optional)
+	Player Player // Device to drive; nil simulates playback with timers only
+
+	// Drift detection (optional)
+	StateSource       StateSource   // Where real playback is reported; defaults to Player
+	ReconcileInterval time.Duration // Interval for polling the state source
+	DriftTolerance    time.Duration // Drift from real playback that is ignored
+	DriftAction       DriftAction   // Always DriftCorrect with a player
 }
 
 // Controller manages playback with an internal queue.
@@ -59,12 +73,29 @@ type Controller struct {
 
 	// Depletion tracking
 	depletionNotified bool
+
+	// Device control
+	playerCh        chan playerCommand
+	playerClosed    bool
+	droppedCommands int // Commands dropped because the player fell behind
+
+	// Drift detection
+	drift               *DriftMeasurement
+	driftAlertedTrackID string
+
+	// Jingles
+	jingles           []track.QueuedTrack
+	jingleIndex       int                 // Next jingle in rotation
+	tracksSinceJingle int                 // Tracks queued since the last jingle
+	lastJingleAt      time.Time           // Expected start of the last queued jingle (or of the session)
+	lastPart          track.RequesterType // Part of the session of the last track queued
+	partStarted       bool                // Whether a track other than a jingle has been queued
 }
 
 // NewController creates a new playback controller.
 func NewController(config Config) *Controller {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &Controller{
+	c := &Controller{
 		queue:   make([]track.QueuedTrack, 0),
 		played:  make([]track.QueuedTrack, 0),
 		state:   StateIdle,
@@ -73,6 +104,24 @@ func NewController(config Config) *Controller {
 		ctx:     ctx,
 		cancel:  cancel,
 	}
+
+	if config.Player != nil {
+		c.config.DriftAction = DriftCorrect
+		if c.config.StateSource == nil {
+			c.config.StateSource = config.Player
+		}
+		c.playerCh = make(chan playerCommand, 32)
+		go c.runPlayer()
+	}
+	if c.config.StateSource != nil && c.config.ReconcileInterval > 0 {
+		if c.config.DriftAction == DriftCorrect {
+			// Measured drift replaces the fixed gap correction
+			c.config.GapCorrection = 0
+		}
+		go c.runReconciler()
+	}
+
+	return c
 }
 
 // Events returns the event channel.
@@ -139,6 +188,7 @@ func (c *Controller) Pause() error {
 
 	c.pausedAt = &now
 	c.state = StatePaused
+	c.sendPlayerCommandLocked("pause", func(ctx context.Context, p Player) error { return p.Pause(ctx) })
 
 	// Note: We don't need special handling for notificationTime during pause
 	// because resumeLocked will calculate the remaining delay based on wall clock.
@@ -186,31 +236,13 @@ func (c *Controller) resumeLocked() error {
 		c.onTrackEndLocked()
 		return nil
 	}
+	c.sendPlayerCommandLocked("resume", func(ctx context.Context, p Player) error { return p.Resume(ctx) })
 
 	now := toWallTime(time.Now())
 
 	// If notification is still pending, reschedule the delay timer
-	if !c.notificationTime.IsZero() && now.Before(c.notificationTime) {
-		delayRemaining := c.notificationTime.Sub(now)
-
-		c.notificationDelayTimerCancel = c.startWallClockTimer(delayRemaining, func() {
-			c.mu.Lock()
-			defer c.mu.Unlock()
-
-			// Clear the cancel func reference as it's already fired/done
-			c.notificationDelayTimerCancel = nil
-
-			if c.currentTrack == nil {
-				return
-			}
-
-			// Send event
-			c.sendEventLocked(Event{
-				Type:  EventTrackStarted,
-				Track: c.currentTrack,
-				State: c.state,
-			})
-		})
+	if c.notificationPendingLocked(now) {
+		c.startNotificationTimerLocked(c.notificationTime.Sub(now))
 	}
 
 	// Restart track timer
@@ -263,7 +295,164 @@ func (c *Controller) Skip() error {
 
 	// Play next track
 	// isContinuous=false because this is a manual skip
-	return c.playNextLocked(false)
+	err := c.playNextLocked(false)
+	if errors.Is(err, ErrQueueEmpty) {
+		// Nothing replaces the skipped track; silence the device
+		c.sendPlayerCommandLocked("pause", func(ctx context.Context, p Player) error { return p.Pause(ctx) })
+	}
+	return err
+}
+
+// Seek moves the current track to the given position, while playing or paused.
+func (c *Controller) Seek(position time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.seekLocked(position)
+}
+
+// Restart plays the current track again from the beginning.
+func (c *Controller) Restart() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.seekLocked(0)
+}
+
+// Previous plays the last played track again. The current track is played
+// again after it, followed by the rest of the queue.
+// Returns the tracks played again in play order, and the queued track that
+// follows them (nil if the queue was empty).
+func (c *Controller) Previous() ([]track.QueuedTrack, *track.QueuedTrack, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.played) == 0 {
+		return nil, nil, ErrNoPreviousTrack
+	}
+
+	// Stop timers
+	if c.timerCancel != nil {
+		c.timerCancel()
+		c.timerCancel = nil
+	}
+	if c.notificationDelayTimerCancel != nil {
+		c.notificationDelayTimerCancel()
+		c.notificationDelayTimerCancel = nil
+	}
+
+	prev := c.played[len(c.played)-1]
+	c.played = c.played[:len(c.played)-1]
+	prev.Replay = true
+	requeued := []track.QueuedTrack{prev}
+	if c.currentTrack != nil && !isJingle(c.currentTrack) {
+		current := *c.currentTrack
+		current.Replay = true
+		requeued = append(requeued, current)
+	}
+	var next *track.QueuedTrack
+	if len(c.queue) > 0 {
+		following := c.queue[0]
+		next = &following
+	}
+	c.queue = append(append([]track.QueuedTrack{}, requeued...), c.queue...)
+
+	c.currentTrack = nil
+	c.pausedAt = nil
+	c.pausedElapsed = 0
+	c.notificationTime = time.Time{}
+	c.depletionNotified = false
+
+	// isContinuous=false because this is a manual change
+	if err := c.playNextLocked(false); err != nil {
+		return nil, nil, err
+	}
+	return requeued, next, nil
+}
+
+// seekLocked moves the current track to the given position.
+// Must be called with lock held.
+func (c *Controller) seekLocked(position time.Duration) error {
+	if c.currentTrack == nil {
+		return ErrNoTrack
+	}
+	slot := c.config.Timing.Slot(c.currentTrack.Track.Duration)
+	slotPosition := c.config.Timing.IntroOffset + position
+	if position < 0 || position >= c.currentTrack.Track.Duration || slotPosition >= slot {
+		return ErrInvalidPosition
+	}
+
+	now := toWallTime(time.Now())
+	c.startTime = now.Add(-slotPosition)
+	c.pausedElapsed = 0
+	if c.state == StatePaused {
+		c.pausedAt = &now
+	}
+
+	// A pending start notification is postponed by the full delay, so that
+	// listeners are notified once the new position has settled
+	notificationPending := c.notificationPendingLocked(now)
+	if c.notificationDelayTimerCancel != nil {
+		c.notificationDelayTimerCancel()
+		c.notificationDelayTimerCancel = nil
+	}
+	if notificationPending {
+		c.notificationTime = now.Add(c.config.NotificationDelay)
+	}
+
+	// While paused, resume reschedules the timers
+	if c.state == StatePlaying {
+		c.startTrackTimer(slot - slotPosition)
+		if notificationPending {
+			c.startNotificationTimerLocked(c.config.NotificationDelay)
+		}
+	}
+
+	c.sendPlayerCommandLocked("seek", func(ctx context.Context, p Player) error { return p.Seek(ctx, position) })
+
+	// Seeking back adds remaining time, so depletion is checked again
+	c.depletionNotified = false
+	c.checkDepletionLocked()
+
+	if !notificationPending {
+		c.sendEventLocked(Event{
+			Type:  EventTrackSeeked,
+			Track: c.currentTrack,
+			State: c.state,
+		})
+	}
+	return nil
+}
+
+// notificationPendingLocked reports whether the start notification of the current track has not been emitted yet.
+// Must be called with lock held.
+func (c *Controller) notificationPendingLocked(now time.Time) bool {
+	return !c.notificationTime.IsZero() && now.Before(c.notificationTime)
+}
+
+// startNotificationTimerLocked emits EventTrackStarted for the current track
+// after delay, unless the track changes in the meantime.
+// Must be called with lock held.
+func (c *Controller) startNotificationTimerLocked(delay time.Duration) {
+	trackID := c.currentTrack.Track.ID
+	c.notificationDelayTimerCancel = c.startWallClockTimer(delay, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		// Clear the cancel func reference as it's already fired/done
+		c.notificationDelayTimerCancel = nil
+
+		if c.currentTrack == nil || c.currentTrack.Track.ID != trackID {
+			return
+		}
+
+		// Send event
+		c.sendEventLocked(Event{
+			Type:  EventTrackStarted,
+			Track: c.currentTrack,
+			State: c.state,
+		})
+	})
 }
 
 // Stop stops playback completely.
@@ -280,6 +469,9 @@ func (c *Controller) Stop() error {
 		c.depletionTimerCancel = nil
 	}
 
+	if c.state == StatePlaying {
+		c.sendPlayerCommandLocked("pause", func(ctx context.Context, p Player) error { return p.Pause(ctx) })
+	}
 	c.currentTrack = nil
 	c.state = StateIdle
 	c.pausedAt = nil
@@ -296,24 +488,122 @@ func (c *Controller) Stop() error {
 	return nil
 }
 
-// Enqueue adds a track to the end of the queue.
-func (c *Controller) Enqueue(qt track.QueuedTrack) {
+// Enqueue adds a track to the end of the queue, preceded by a jingle if one is due.
+// Returns the tracks added, in queue order.
+func (c *Controller) Enqueue(qt track.QueuedTrack) []track.QueuedTrack {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.queue = append(c.queue, qt)
+	added := c.appendLocked(qt)
 	c.depletionNotified = false // Reset depletion flag when track is added
 	c.checkDepletionLocked()    // Reschedule depletion timer
+	return added
 }
 
-// EnqueueMultiple adds multiple tracks to the end of the queue.
-func (c *Controller) EnqueueMultiple(qts []track.QueuedTrack) {
+// EnqueueIf adds a track to the queue if admit accepts it.
+// The track is placed ahead of the trailing queued tracks that overtake accepts
+// (nil: at the end of the queue). admit receives the time until the track would
+// start (the current track's remaining time plus the tracks ahead of it). It is
+// called with the lock held, so concurrent callers see each other's tracks; it
+// must not call the controller. A track placed at the end of the queue is
+// preceded by a jingle if one is due, and startsIn includes it.
+// Returns the tracks added in queue order (nil if the track was not admitted),
+// and the tracks it was placed ahead of.
+func (c *Controller) EnqueueIf(qt track.QueuedTrack, overtake func(track.QueuedTrack) bool, admit func(startsIn time.Duration) bool) ([]track.QueuedTrack, []track.QueuedTrack) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.queue = append(c.queue, qts...)
+	index := len(c.queue)
+	for overtake != nil && index > 0 && overtake(c.queue[index-1]) {
+		index--
+	}
+
+	startsIn := c.startsInLocked(index)
+	if index == len(c.queue) {
+		if jingle, ok := c.jingleDueLocked(qt, toWallTime(time.Now()).Add(startsIn)); ok {
+			startsIn += c.config.Timing.Slot(jingle.Track.Duration)
+		}
+	}
+	if !admit(startsIn) {
+		return nil, nil
+	}
+
+	var added []track.QueuedTrack
+	overtaken := make([]track.QueuedTrack, len(c.queue)-index)
+	copy(overtaken, c.queue[index:])
+	if index == len(c.queue) {
+		added = c.appendLocked(qt)
+	} else {
+		added = []track.QueuedTrack{qt}
+		c.queue = append(c.queue[:index], append(added, overtaken...)...)
+	}
+	c.depletionNotified = false // Reset depletion flag when track is added
+	c.checkDepletionLocked()    // Reschedule depletion timer
+	return added, overtaken
+}
+
+// RetractTail removes tracks from the end of the queue as long as retract
+// accepts the current queue, whose last track is the one to remove.
+// retract is called with the lock held and must not call the controller.
+// Returns the removed tracks in queue order.
+func (c *Controller) RetractTail(retract func(queue []track.QueuedTrack) bool) []track.QueuedTrack {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	index := len(c.queue)
+	for index > 0 && retract(c.queue[:index]) {
+		index--
+	}
+	if index == len(c.queue) {
+		return nil
+	}
+
+	retracted := make([]track.QueuedTrack, len(c.queue)-index)
+	copy(retracted, c.queue[index:])
+	c.queue = c.queue[:index]
+	c.checkDepletionLocked()
+	return retracted
+}
+
+// EnqueueMultiple adds multiple tracks to the end of the queue, with jingles
+// interleaved where they are due.
+// Returns the tracks added, in queue order.
+func (c *Controller) EnqueueMultiple(qts []track.QueuedTrack) []track.QueuedTrack {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	added := make([]track.QueuedTrack, 0, len(qts))
+	for _, qt := range qts {
+		added = append(added, c.appendLocked(qt)...)
+	}
 	c.depletionNotified = false // Reset depletion flag when tracks are added
 	c.checkDepletionLocked()    // Reschedule depletion timer
+	return added
+}
+
+// Insert inserts a track into the queue at the given index (0 = next to play).
+// An index out of range (negative or beyond the queue length) appends the track.
+// Inserted tracks are placed exactly and never bring a jingle.
+// Returns the index at which the track was inserted and the track that now
+// follows it (nil if the track was appended).
+func (c *Controller) Insert(qt track.QueuedTrack, index int) (int, *track.QueuedTrack) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var next *track.QueuedTrack
+	if index < 0 || index >= len(c.queue) {
+		index = len(c.queue)
+		c.queue = append(c.queue, qt)
+	} else {
+		following := c.queue[index]
+		next = &following
+		c.queue = append(c.queue, track.QueuedTrack{})
+		copy(c.queue[index+1:], c.queue[index:])
+		c.queue[index] = qt
+	}
+	c.depletionNotified = false // Reset depletion flag when track is added
+	c.checkDepletionLocked()    // Reschedule depletion timer
+	return index, next
 }
 
 // ClearQueue removes all tracks from the queue.
@@ -326,6 +616,31 @@ func (c *Controller) ClearQueue() []track.QueuedTrack {
 	return removed
 }
 
+// RetainQueue removes the queued tracks for which keep returns false.
+// keep is called for each queued track in queue order. A kept jingle that
+// would end up right before another jingle is removed as well.
+// Returns the removed tracks.
+func (c *Controller) RetainQueue(keep func(qt track.QueuedTrack) bool) []track.QueuedTrack {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := make([]track.QueuedTrack, 0, len(c.queue))
+	var removed []track.QueuedTrack
+	for _, qt := range c.queue {
+		if !keep(qt) {
+			removed = append(removed, qt)
+			continue
+		}
+		if isJingle(&qt) && len(kept) > 0 && isJingle(&kept[len(kept)-1]) {
+			removed = append(removed, kept[len(kept)-1])
+			kept = kept[:len(kept)-1]
+		}
+		kept = append(kept, qt)
+	}
+	c.queue = kept
+	return removed
+}
+
 // GetState returns the current playback state.
 func (c *Controller) GetState() State {
 	c.mu.RLock()
@@ -356,23 +671,41 @@ func (c *Controller) getRemainingDurationLocked() time.Duration {
 		return 0
 	}
 
-	now := toWallTime(time.Now())
+	slot := c.config.Timing.Slot(c.currentTrack.Track.Duration)
+	elapsed := c.slotElapsedLocked(toWallTime(time.Now()))
+
+	remaining := slot - elapsed
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
 
-	// If still before actual start time (delay period), return full duration
+// startsInLocked returns the time until the queued track at index starts:
+// the current track's remaining time plus the tracks ahead of it.
+// Must be called with lock held.
+func (c *Controller) startsInLocked(index int) time.Duration {
+	startsIn := c.getRemainingDurationLocked()
+	for _, queued := range c.queue[:index] {
+		startsIn += c.config.Timing.Slot(queued.Track.Duration)
+	}
+	return startsIn
+}
+
+// slotElapsedLocked returns the time since the current track's slot started,
+// excluding pauses. The slot starts with the timing's intro offset.
+// Must be called with lock held.
+func (c *Controller) slotElapsedLocked(now time.Time) time.Duration {
+	// Still before actual start time (delay period)
 	if now.Before(c.startTime) {
-		return c.currentTrack.Track.Duration
+		return 0
 	}
 
 	elapsed := now.Sub(c.startTime) - c.pausedElapsed
 	if c.state == StatePaused && c.pausedAt != nil {
 		elapsed -= now.Sub(*c.pausedAt)
 	}
-
-	remaining := c.currentTrack.Track.Duration - elapsed
-	if remaining < 0 {
-		return 0
-	}
-	return remaining
+	return elapsed
 }
 
 // GetQueueSize returns the number of tracks in the queue.
@@ -469,23 +802,36 @@ func (c *Controller) GetAllTracks() []track.QueuedTrack {
 	return result
 }
 
-// GetTotalDuration returns the total duration of all queued tracks.
+// GetTotalDuration returns the time until all queued tracks have started
+// playing after the current one, according to the timing.
 func (c *Controller) GetTotalDuration() time.Duration {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	var total time.Duration
 	for _, qt := range c.queue {
-		total += qt.Track.Duration
+		total += c.config.Timing.Slot(qt.Track.Duration)
 	}
 	return total
 }
 
+// GetTiming returns the timing model tracks are scheduled with.
+func (c *Controller) GetTiming() Timing {
+	return c.config.Timing
+}
+
 // Close closes the controller and releases resources.
 func (c *Controller) Close() {
 	c.cancel()
 	_ = c.Stop()
 	close(c.eventCh)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.playerCh != nil && !c.playerClosed {
+		c.playerClosed = true
+		close(c.playerCh) // runPlayer finishes the pending commands and exits
+	}
 }
 
 // playNextLocked plays the next track from the queue.
@@ -507,7 +853,7 @@ func (c *Controller) playNextLocked(isContinuous bool) error {
 
 	// Add to played history
 	if c.currentTrack != nil {
-		c.played = append(c.played, *c.currentTrack)
+		c.addPlayedLocked(*c.currentTrack)
 	}
 
 	// Set current track
@@ -537,13 +883,19 @@ func (c *Controller) playNextLocked(isContinuous bool) error {
 	c.scheduledStartTime = startBase
 	c.startTime = c.scheduledStartTime
 
+	trackID := qt.Track.ID
+	c.sendPlayerCommandLocked("play", func(ctx context.Context, p Player) error { return p.PlayTrack(ctx, trackID, 0) })
+
 	// Set timer for track end
 	// The track timer must account for the gap because during the gap, the track hasn't technically started playing on the client yet
-	c.startTrackTimer(qt.Track.Duration + gapCorrection)
+	c.startTrackTimer(c.config.Timing.Slot(qt.Track.Duration) + gapCorrection)
 
 	// Check depletion
 	c.checkDepletionLocked()
 
+	// Listeners hear the track only after the intro offset
+	notificationDelay += c.config.Timing.IntroOffset
+
 	if notificationDelay > 0 {
 		c.notificationTime = c.scheduledStartTime.Add(notificationDelay)
 		zlog.Debug().Msgf("playback: setting notification delay timer: delay=%v gap=%v track=%s duration=%v",
@@ -617,7 +969,7 @@ func (c *Controller) onTrackEndLocked() {
 	}
 
 	// Add to played history
-	c.played = append(c.played, *endedTrack)
+	c.addPlayedLocked(*endedTrack)
 
 	// Clear current track
 	c.currentTrack = nil
@@ -636,6 +988,15 @@ func (c *Controller) onTrackEndLocked() {
 	_ = c.playNextLocked(true)
 }
 
+// addPlayedLocked adds a track to the played history. Jingles are left out.
+// Must be called with lock held.
+func (c *Controller) addPlayedLocked(qt track.QueuedTrack) {
+	if isJingle(&qt) {
+		return
+	}
+	c.played = append(c.played, qt)
+}
+
 // checkDepletionLocked checks if queue is depleting and sends event.
 // Must be called with lock held.
 func (c *Controller) checkDepletionLocked() {
@@ -659,7 +1020,7 @@ func (c *Controller) checkDepletionLocked() {
 
 	// Add duration of all queued tracks
 	for _, qt := range c.queue {
-		totalRemaining += qt.Track.Duration
+		totalRemaining += c.config.Timing.Slot(qt.Track.Duration)
 	}
 
 	threshold := time.Duration(c.config.DepletionThresholdSec) * time.Second
@@ -0,0 +1,170 @@
+package playback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func queuedTrack(id string) track.QueuedTrack {
+	return track.QueuedTrack{Track: track.Track{ID: id, Name: id, Duration: 3 * time.Minute}}
+}
+
+func queueIDs(c *Controller) []string {
+	queued := c.GetQueuedTracks()
+	ids := make([]string, len(queued))
+	for i, qt := range queued {
+		ids[i] = qt.Track.ID
+	}
+	return ids
+}
+
+func TestController_Insert(t *testing.T) {
+	tests := []struct {
+		name      string
+		index     int
+		wantIndex int
+		wantNext  string
+		wantQueue []string
+	}{
+		{name: "front", index: 0, wantIndex: 0, wantNext: "a", wantQueue: []string{"x", "a", "b"}},
+		{name: "middle", index: 1, wantIndex: 1, wantNext: "b", wantQueue: []string{"a", "x", "b"}},
+		{name: "end", index: 2, wantIndex: 2, wantQueue: []string{"a", "b", "x"}},
+		{name: "negative appends", index: -1, wantIndex: 2, wantQueue: []string{"a", "b", "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewController(Config{})
+			defer c.Close()
+			c.EnqueueMultiple([]track.QueuedTrack{queuedTrack("a"), queuedTrack("b")})
+
+			index, next := c.Insert(queuedTrack("x"), tt.index)
+
+			assert.Equal(t, tt.wantIndex, index)
+			if tt.wantNext == "" {
+				assert.Nil(t, next)
+			} else {
+				require.NotNil(t, next)
+				assert.Equal(t, tt.wantNext, next.Track.ID)
+			}
+			assert.Equal(t, tt.wantQueue, queueIDs(c))
+		})
+	}
+}
+
+// playing returns a controller playing the first of the given tracks.
+func playing(t *testing.T, ids ...string) *Controller {
+	t.Helper()
+	c := NewController(Config{})
+	t.Cleanup(c.Close)
+	for _, id := range ids {
+		c.Enqueue(queuedTrack(id))
+	}
+	require.NoError(t, c.Play())
+	return c
+}
+
+func TestController_Seek(t *testing.T) {
+	tests := []struct {
+		name          string
+		position      time.Duration
+		wantErr       error
+		wantRemaining time.Duration
+	}{
+		{name: "forward", position: time.Minute, wantRemaining: 2 * time.Minute},
+		{name: "start", position: 0, wantRemaining: 3 * time.Minute},
+		{name: "last second", position: 3*time.Minute - time.Second, wantRemaining: time.Second},
+		{name: "end of track", position: 3 * time.Minute, wantErr: ErrInvalidPosition},
+		{name: "negative", position: -time.Second, wantErr: ErrInvalidPosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := playing(t, "a")
+
+			err := c.Seek(tt.position)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantRemaining, c.GetRemainingDuration(), float64(time.Second))
+		})
+	}
+}
+
+func TestController_Seek_Paused(t *testing.T) {
+	c := playing(t, "a")
+	require.NoError(t, c.Pause())
+
+	require.NoError(t, c.Seek(time.Minute))
+
+	assert.Equal(t, StatePaused, c.GetState())
+	assert.InDelta(t, 2*time.Minute, c.GetRemainingDuration(), float64(time.Second))
+}
+
+func TestController_Seek_NoTrack(t *testing.T) {
+	c := NewController(Config{})
+	defer c.Close()
+
+	assert.ErrorIs(t, c.Seek(time.Minute), ErrNoTrack)
+	assert.ErrorIs(t, c.Restart(), ErrNoTrack)
+}
+
+func TestController_Restart(t *testing.T) {
+	c := playing(t, "a")
+	require.NoError(t, c.Seek(2*time.Minute))
+
+	require.NoError(t, c.Restart())
+
+	current, ok := c.GetCurrentTrack()
+	require.True(t, ok)
+	assert.Equal(t, "a", current.Track.ID)
+	assert.InDelta(t, 3*time.Minute, c.GetRemainingDuration(), float64(time.Second))
+}
+
+func TestController_Previous(t *testing.T) {
+	c := playing(t, "a", "b", "c")
+
+	_, _, err := c.Previous()
+	assert.ErrorIs(t, err, ErrNoPreviousTrack)
+
+	c.onTrackEnd() // a has been played, b is playing
+
+	requeued, next, err := c.Previous()
+	require.NoError(t, err)
+
+	require.Len(t, requeued, 2)
+	assert.Equal(t, "a", requeued[0].Track.ID)
+	assert.Equal(t, "b", requeued[1].Track.ID)
+	assert.True(t, requeued[0].Replay)
+	assert.True(t, requeued[1].Replay)
+	require.NotNil(t, next)
+	assert.Equal(t, "c", next.Track.ID)
+
+	current, ok := c.GetCurrentTrack()
+	require.True(t, ok)
+	assert.Equal(t, "a", current.Track.ID)
+	assert.Equal(t, []string{"b", "c"}, queueIDs(c))
+
+	_, _, err = c.Previous()
+	assert.ErrorIs(t, err, ErrNoPreviousTrack, "the replayed track is taken off the history")
+}
+
+func TestController_Previous_EmptyQueue(t *testing.T) {
+	c := playing(t, "a", "b")
+	c.onTrackEnd()
+
+	requeued, next, err := c.Previous()
+	require.NoError(t, err)
+
+	assert.Len(t, requeued, 2)
+	assert.Nil(t, next)
+	assert.Equal(t, []string{"b"}, queueIDs(c))
+}
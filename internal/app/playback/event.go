@@ -12,6 +12,8 @@ const (
 	EventStateChanged                    // Playback state changed (pause/resume)
 	EventQueueDepleting                  // Queue is depleting (remaining time below threshold)
 	EventQueueEmpty                      // Queue became empty
+	EventDriftDetected                   // Position drifted from real playback (alert mode only)
+	EventTrackSeeked                     // Position of the current track was changed
 )
 
 // String returns the string representation of the event type.
@@ -29,6 +31,10 @@ func (e EventType) String() string {
 		return "queue_depleting"
 	case EventQueueEmpty:
 		return "queue_empty"
+	case EventDriftDetected:
+		return "drift_detected"
+	case EventTrackSeeked:
+		return "track_seeked"
 	default:
 		return "unknown"
 	}
@@ -39,4 +45,5 @@ type Event struct {
 	Type  EventType
 	Track *track.QueuedTrack // Current track (nil for some events)
 	State State              // Current playback state
+	Drift *DriftMeasurement  // Measured drift (EventDriftDetected only)
 }
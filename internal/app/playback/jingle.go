@@ -0,0 +1,120 @@
+package playback
+
+import (
+	"time"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// JingleSchedule describes when the controller plays a jingle (station ID)
+// between two tracks. The zero value never plays jingles.
+type JingleSchedule struct {
+	EveryTracks  int           // Play a jingle after this many tracks (0: disabled)
+	Every        time.Duration // Play a jingle when this much time has passed since the last one (0: disabled)
+	AtBoundaries bool          // Play a jingle at session start and between the opening, main, segment and ending parts
+}
+
+// SetJingles sets the tracks played as jingles, in rotation.
+// The controller queues a jingle ahead of a track added to the end of the
+// queue when the schedule is due, so jingles count towards the queue duration
+// like any other track. Tracks inserted ahead of queued tracks never bring a
+// jingle. Jingles are kept out of the played history.
+func (c *Controller) SetJingles(jingles []track.QueuedTrack) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.jingles = make([]track.QueuedTrack, len(jingles))
+	for i, qt := range jingles {
+		qt.Requester.Type = track.RequesterTypeJingle
+		c.jingles[i] = qt
+	}
+	c.jingleIndex = 0
+}
+
+// appendLocked adds a track to the end of the queue, preceded by a jingle if one is due.
+// Returns the tracks added, in queue order.
+// Must be called with lock held.
+func (c *Controller) appendLocked(qt track.QueuedTrack) []track.QueuedTrack {
+	startsAt := toWallTime(time.Now()).Add(c.startsInLocked(len(c.queue)))
+
+	added := make([]track.QueuedTrack, 0, 2)
+	if jingle, ok := c.jingleDueLocked(qt, startsAt); ok {
+		c.queue = append(c.queue, jingle)
+		c.scheduleLocked(jingle, startsAt)
+		added = append(added, jingle)
+		startsAt = startsAt.Add(c.config.Timing.Slot(jingle.Track.Duration))
+	}
+	c.queue = append(c.queue, qt)
+	c.scheduleLocked(qt, startsAt)
+	return append(added, qt)
+}
+
+// jingleDueLocked returns the jingle to queue ahead of a track added to the
+// end of the queue that would start at startsAt, if one is due.
+// It does not change the schedule.
+// Must be called with lock held.
+func (c *Controller) jingleDueLocked(next track.QueuedTrack, startsAt time.Time) (track.QueuedTrack, bool) {
+	schedule := c.config.Jingles
+	// Never two jingles in a row, and never before a track played again on request
+	if len(c.jingles) == 0 || isJingle(c.tailLocked()) || isJingle(&next) || next.Replay {
+		return track.QueuedTrack{}, false
+	}
+
+	due := schedule.AtBoundaries && (!c.partStarted || partOf(next.Requester.Type) != c.lastPart)
+	due = due || schedule.EveryTracks > 0 && c.tracksSinceJingle >= schedule.EveryTracks
+	due = due || schedule.Every > 0 && !c.lastJingleAt.IsZero() && startsAt.Sub(c.lastJingleAt) >= schedule.Every
+	if !due {
+		return track.QueuedTrack{}, false
+	}
+
+	jingle := c.jingles[c.jingleIndex%len(c.jingles)]
+	jingle.AddedAt = time.Now()
+	return jingle, true
+}
+
+// scheduleLocked records a track added to the end of the queue, starting at
+// startsAt, for the jingle schedule.
+// Must be called with lock held.
+func (c *Controller) scheduleLocked(qt track.QueuedTrack, startsAt time.Time) {
+	if isJingle(&qt) {
+		c.jingleIndex++
+		c.tracksSinceJingle = 0
+		c.lastJingleAt = startsAt
+		return
+	}
+
+	if c.lastJingleAt.IsZero() {
+		// The time schedule starts with the first track of the session
+		c.lastJingleAt = startsAt
+	}
+	if !qt.Replay {
+		c.tracksSinceJingle++
+		c.lastPart = partOf(qt.Requester.Type)
+		c.partStarted = true
+	}
+}
+
+// tailLocked returns the last queued track, or the current track if the queue is empty.
+// Must be called with lock held.
+func (c *Controller) tailLocked() *track.QueuedTrack {
+	if len(c.queue) > 0 {
+		return &c.queue[len(c.queue)-1]
+	}
+	return c.currentTrack
+}
+
+// partOf returns the part of the session a track belongs to: the opening,
+// the ending, a segment playlist, or the main part (empty) for every other track.
+func partOf(t track.RequesterType) track.RequesterType {
+	switch t {
+	case track.RequesterTypeOpening, track.RequesterTypeEnding, track.RequesterTypeSegment:
+		return t
+	default:
+		return ""
+	}
+}
+
+// isJingle reports whether a track was played as a jingle.
+func isJingle(qt *track.QueuedTrack) bool {
+	return qt != nil && qt.Requester.Type == track.RequesterTypeJingle
+}
@@ -0,0 +1,151 @@
+package playback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func jingleTrack(id string) track.QueuedTrack {
+	return track.QueuedTrack{Track: track.Track{ID: id, Name: id, Duration: 30 * time.Second}}
+}
+
+func partTrack(id string, requesterType track.RequesterType) track.QueuedTrack {
+	qt := queuedTrack(id)
+	qt.Requester.Type = requesterType
+	return qt
+}
+
+func replayTrack(id string) track.QueuedTrack {
+	qt := queuedTrack(id)
+	qt.Replay = true
+	return qt
+}
+
+func TestController_Enqueue_Jingles(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedule  JingleSchedule
+		tracks    []track.QueuedTrack
+		wantQueue []string
+	}{
+		{
+			name:      "disabled",
+			tracks:    []track.QueuedTrack{queuedTrack("a"), queuedTrack("b"), queuedTrack("c")},
+			wantQueue: []string{"a", "b", "c"},
+		},
+		{
+			name:      "every tracks",
+			schedule:  JingleSchedule{EveryTracks: 2},
+			tracks:    []track.QueuedTrack{queuedTrack("a"), queuedTrack("b"), queuedTrack("c"), queuedTrack("d"), queuedTrack("e")},
+			wantQueue: []string{"a", "b", "j1", "c", "d", "j2", "e"},
+		},
+		{
+			name:      "every duration",
+			schedule:  JingleSchedule{Every: 5 * time.Minute},
+			tracks:    []track.QueuedTrack{queuedTrack("a"), queuedTrack("b"), queuedTrack("c"), queuedTrack("d"), queuedTrack("e")},
+			wantQueue: []string{"a", "b", "j1", "c", "d", "j2", "e"},
+		},
+		{
+			name:     "at boundaries",
+			schedule: JingleSchedule{AtBoundaries: true},
+			tracks: []track.QueuedTrack{
+				partTrack("a", track.RequesterTypeOpening),
+				partTrack("b", track.RequesterTypeOpening),
+				partTrack("c", track.RequesterTypeUser),
+				partTrack("d", track.RequesterTypeBGM),
+				partTrack("e", track.RequesterTypeEnding),
+			},
+			wantQueue: []string{"j1", "a", "b", "j2", "c", "d", "j1", "e"},
+		},
+		{
+			name:      "never before a replay",
+			schedule:  JingleSchedule{EveryTracks: 1},
+			tracks:    []track.QueuedTrack{queuedTrack("a"), replayTrack("a"), queuedTrack("b")},
+			wantQueue: []string{"a", "a", "j1", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewController(Config{Jingles: tt.schedule})
+			defer c.Close()
+			c.SetJingles([]track.QueuedTrack{jingleTrack("j1"), jingleTrack("j2")})
+
+			var added []string
+			for _, qt := range tt.tracks {
+				for _, a := range c.Enqueue(qt) {
+					added = append(added, a.Track.ID)
+				}
+			}
+
+			assert.Equal(t, tt.wantQueue, queueIDs(c))
+			assert.Equal(t, tt.wantQueue, added)
+		})
+	}
+}
+
+func TestController_Enqueue_JingleCountsTowardsDuration(t *testing.T) {
+	c := NewController(Config{Jingles: JingleSchedule{AtBoundaries: true}})
+	defer c.Close()
+	c.SetJingles([]track.QueuedTrack{jingleTrack("j1")})
+
+	c.Enqueue(queuedTrack("a"))
+
+	assert.Equal(t, 3*time.Minute+30*time.Second, c.GetTotalDuration())
+}
+
+func TestController_EnqueueIf_Jingles(t *testing.T) {
+	c := NewController(Config{Jingles: JingleSchedule{EveryTracks: 1}})
+	defer c.Close()
+	c.SetJingles([]track.QueuedTrack{jingleTrack("j1")})
+	c.Enqueue(partTrack("a", track.RequesterTypeUser))
+	c.Enqueue(partTrack("b", track.RequesterTypeBGM))
+
+	// Appended: the jingle due before the track is queued and counts towards its start
+	var startsIn time.Duration
+	added, overtaken := c.EnqueueIf(partTrack("c", track.RequesterTypeUser), nil, func(d time.Duration) bool {
+		startsIn = d
+		return true
+	})
+	assert.Equal(t, []string{"j1", "c"}, idsOf(added))
+	assert.Empty(t, overtaken)
+	assert.Equal(t, 7*time.Minute, startsIn)
+
+	// Placed ahead of queued tracks: no jingle
+	added, overtaken = c.EnqueueIf(partTrack("d", track.RequesterTypeUser), func(qt track.QueuedTrack) bool {
+		return qt.Track.ID == "c"
+	}, func(time.Duration) bool { return true })
+	assert.Equal(t, []string{"d"}, idsOf(added))
+	assert.Equal(t, []string{"c"}, idsOf(overtaken))
+
+	// Not admitted: nothing is queued
+	added, _ = c.EnqueueIf(partTrack("e", track.RequesterTypeUser), nil, func(time.Duration) bool { return false })
+	assert.Nil(t, added)
+	assert.Equal(t, []string{"a", "j1", "b", "j1", "d", "c"}, queueIDs(c))
+}
+
+func TestController_RetainQueue_CollapsesJingles(t *testing.T) {
+	c := NewController(Config{Jingles: JingleSchedule{EveryTracks: 1}})
+	defer c.Close()
+	c.SetJingles([]track.QueuedTrack{jingleTrack("j1"), jingleTrack("j2")})
+	c.EnqueueMultiple([]track.QueuedTrack{queuedTrack("a"), queuedTrack("b"), queuedTrack("c")})
+	require.Equal(t, []string{"a", "j1", "b", "j2", "c"}, queueIDs(c))
+
+	removed := c.RetainQueue(func(qt track.QueuedTrack) bool { return qt.Track.ID != "b" })
+
+	assert.Equal(t, []string{"b", "j1"}, idsOf(removed))
+	assert.Equal(t, []string{"a", "j2", "c"}, queueIDs(c))
+}
+
+func idsOf(qts []track.QueuedTrack) []string {
+	ids := make([]string, len(qts))
+	for i, qt := range qts {
+		ids[i] = qt.Track.ID
+	}
+	return ids
+}
@@ -0,0 +1,77 @@
+package playback
+
+import (
+	"context"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+)
+
+// playerCommandTimeout bounds a single call to the player.
+const playerCommandTimeout = 10 * time.Second
+
+// Player drives audio on a real playback device.
+// When the controller has a player, its state changes are mirrored to the device
+// and its timers follow the progress the device reports.
+type Player interface {
+	StateSource
+	PlayTrack(ctx context.Context, trackID string, position time.Duration) error
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	Seek(ctx context.Context, position time.Duration) error
+}
+
+// StateSource reports where real playback is.
+type StateSource interface {
+	// State returns the device's current track and progress, or nil if nothing is playing.
+	State(ctx context.Context) (*PlayerState, error)
+}
+
+// PlayerState is the playback state reported by a device.
+type PlayerState struct {
+	TrackID  string
+	Progress time.Duration
+	Playing  bool
+}
+
+// sendPlayerCommandLocked queues a command for the player.
+// Commands run in order on a separate goroutine so that API calls are never made under the lock.
+// If the player has fallen behind and the queue is full, the command is dropped
+// rather than blocking the controller, and counted; drift correction realigns the device.
+// Must be called with lock held.
+func (c *Controller) sendPlayerCommandLocked(name string, fn func(ctx context.Context, p Player) error) {
+	if c.config.Player == nil || c.playerClosed {
+		return
+	}
+	select {
+	case c.playerCh <- playerCommand{name: name, fn: fn}:
+	default:
+		c.droppedCommands++
+		zlog.Error().Msgf("playback: device command queue is full, dropping command: command=%s dropped_total=%d", name, c.droppedCommands)
+	}
+}
+
+// DroppedPlayerCommands returns the number of device commands dropped because
+// the player fell behind.
+func (c *Controller) DroppedPlayerCommands() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.droppedCommands
+}
+
+// playerCommand is a queued call to the player.
+type playerCommand struct {
+	name string
+	fn   func(ctx context.Context, p Player) error
+}
+
+// runPlayer executes player commands until the command channel is closed.
+func (c *Controller) runPlayer() {
+	for cmd := range c.playerCh {
+		ctx, cancel := context.WithTimeout(context.Background(), playerCommandTimeout)
+		if err := cmd.fn(ctx, c.config.Player); err != nil {
+			zlog.Error().Msgf("playback: device command failed: command=%s error=%v", cmd.name, err)
+		}
+		cancel()
+	}
+}
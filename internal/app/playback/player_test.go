@@ -0,0 +1,56 @@
+package playback
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stalledPlayer blocks every call until release is closed.
+type stalledPlayer struct {
+	release chan struct{}
+}
+
+func (p *stalledPlayer) wait(ctx context.Context) error {
+	select {
+	case <-p.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *stalledPlayer) PlayTrack(ctx context.Context, trackID string, position time.Duration) error {
+	return p.wait(ctx)
+}
+func (p *stalledPlayer) Pause(ctx context.Context) error                        { return p.wait(ctx) }
+func (p *stalledPlayer) Resume(ctx context.Context) error                       { return p.wait(ctx) }
+func (p *stalledPlayer) Seek(ctx context.Context, position time.Duration) error { return p.wait(ctx) }
+func (p *stalledPlayer) State(ctx context.Context) (*PlayerState, error)        { return nil, p.wait(ctx) }
+
+func TestController_SendPlayerCommand_StalledPlayer(t *testing.T) {
+	p := &stalledPlayer{release: make(chan struct{})}
+	c := NewController(Config{Player: p})
+	defer c.Close()
+	defer close(p.release)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i := 0; i < 2*cap(c.playerCh); i++ {
+			c.sendPlayerCommandLocked("pause", func(ctx context.Context, p Player) error { return p.Pause(ctx) })
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sending commands to a stalled player blocked the controller")
+	}
+	// The player takes one command, the queue holds the next ones, and the rest are counted
+	assert.GreaterOrEqual(t, c.DroppedPlayerCommands(), cap(c.playerCh)-1)
+}
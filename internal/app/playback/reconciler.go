@@ -0,0 +1,166 @@
+package playback
+
+import (
+	"context"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+)
+
+// DriftAction is what the controller does when its position drifts from real playback.
+type DriftAction int
+
+const (
+	DriftCorrect DriftAction = iota // Align the timers with real playback
+	DriftAlert                      // Only report the drift with EventDriftDetected
+)
+
+// DriftMeasurement is the result of comparing the controller with real playback.
+type DriftMeasurement struct {
+	TrackID    string        // Track the device was playing
+	Drift      time.Duration // Controller position minus device position (positive: controller is ahead)
+	MeasuredAt time.Time
+}
+
+// GetDrift returns the latest drift measurement.
+// Returns false if drift has not been measured yet.
+func (c *Controller) GetDrift() (DriftMeasurement, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.drift == nil {
+		return DriftMeasurement{}, false
+	}
+	return *c.drift, true
+}
+
+// runReconciler periodically compares the controller with the playback the
+// state source reports, until the controller is closed.
+func (c *Controller) runReconciler() {
+	ticker := time.NewTicker(c.config.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(c.ctx, playerCommandTimeout)
+			ps, err := c.config.StateSource.State(ctx)
+			cancel()
+			if err != nil {
+				zlog.Warn().Msgf("playback: failed to get device state: %v", err)
+				continue
+			}
+			c.reconcile(ps, toWallTime(time.Now()))
+		}
+	}
+}
+
+// reconcile measures drift from the reported playback and corrects or reports it.
+func (c *Controller) reconcile(ps *PlayerState, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.currentTrack == nil || c.state != StatePlaying || ps == nil {
+		return
+	}
+
+	if ps.TrackID != c.currentTrack.Track.ID {
+		c.reconcileTrackMismatchLocked(ps, now)
+		return
+	}
+
+	if !ps.Playing {
+		// A controlled device stops after the track; if that happened since the
+		// last poll, move on now instead of waiting for the timer.
+		if c.config.Player != nil && c.getRemainingDurationLocked() <= c.config.ReconcileInterval {
+			zlog.Debug().Msgf("playback: device finished the track early: track=%s", c.currentTrack.Track.Name)
+			c.onTrackEndLocked()
+		} else {
+			zlog.Warn().Msgf("playback: device is paused outside of the session: track=%s", c.currentTrack.Track.Name)
+		}
+		return
+	}
+
+	elapsed := c.slotElapsedLocked(now) - c.config.Timing.IntroOffset
+	drift := elapsed - ps.Progress
+	c.drift = &DriftMeasurement{TrackID: ps.TrackID, Drift: drift, MeasuredAt: now}
+	if absDuration(drift) <= c.config.DriftTolerance {
+		return
+	}
+
+	if c.config.DriftAction == DriftAlert {
+		c.alertDriftLocked()
+		return
+	}
+
+	zlog.Debug().Msgf("playback: correcting drift: track=%s server_elapsed=%v device_progress=%v",
+		c.currentTrack.Track.Name, elapsed, ps.Progress)
+	c.alignToProgressLocked(ps.Progress, now)
+}
+
+// reconcileTrackMismatchLocked handles a device that plays another track than the controller.
+// Must be called with lock held.
+func (c *Controller) reconcileTrackMismatchLocked(ps *PlayerState, now time.Time) {
+	// A controlled device has not switched yet, or was taken over by another app
+	if c.config.Player != nil {
+		zlog.Debug().Msgf("playback: device is not playing the current track: expected=%s actual=%s",
+			c.currentTrack.Track.ID, ps.TrackID)
+		return
+	}
+
+	// The host has already moved on to the next track: the controller is behind
+	if len(c.queue) == 0 || c.queue[0].Track.ID != ps.TrackID {
+		zlog.Debug().Msgf("playback: host is not playing a session track in order: expected=%s actual=%s",
+			c.currentTrack.Track.ID, ps.TrackID)
+		return
+	}
+
+	drift := -(c.getRemainingDurationLocked() + c.config.Timing.IntroOffset + ps.Progress)
+	c.drift = &DriftMeasurement{TrackID: ps.TrackID, Drift: drift, MeasuredAt: now}
+	if c.config.DriftAction == DriftAlert {
+		c.alertDriftLocked()
+		return
+	}
+
+	zlog.Debug().Msgf("playback: host is ahead by a track, advancing: drift=%v", drift)
+	c.onTrackEndLocked()
+	if c.currentTrack != nil && c.currentTrack.Track.ID == ps.TrackID {
+		c.alignToProgressLocked(ps.Progress, now)
+	}
+}
+
+// alignToProgressLocked moves the current track's timers to the given position.
+// Must be called with lock held.
+func (c *Controller) alignToProgressLocked(progress time.Duration, now time.Time) {
+	slotPosition := c.config.Timing.IntroOffset + progress
+	c.startTime = now.Add(-slotPosition)
+	c.pausedElapsed = 0
+	c.startTrackTimer(c.config.Timing.Slot(c.currentTrack.Track.Duration) - slotPosition)
+	c.checkDepletionLocked()
+}
+
+// alertDriftLocked reports the latest drift measurement once per track.
+// Must be called with lock held.
+func (c *Controller) alertDriftLocked() {
+	if c.driftAlertedTrackID == c.currentTrack.Track.ID {
+		return
+	}
+	c.driftAlertedTrackID = c.currentTrack.Track.ID
+
+	drift := *c.drift
+	c.sendEventLocked(Event{
+		Type:  EventDriftDetected,
+		Track: c.currentTrack,
+		State: c.state,
+		Drift: &drift,
+	})
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
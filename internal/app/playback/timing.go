@@ -0,0 +1,43 @@
+package playback
+
+import (
+	"time"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// minSlot is the shortest time a track holds the timeline, however the timing is configured.
+const minSlot = time.Second
+
+// Timing describes how tracks follow each other on the host's player.
+// The zero value plays tracks back to back.
+type Timing struct {
+	Crossfade   time.Duration // Overlap with the next track (the player's crossfade setting)
+	IntroOffset time.Duration // Lead-in before each track is heard (e.g. a pre-roll or silence)
+	OutroOffset time.Duration // Time cut from the end of each track (e.g. trailing silence)
+}
+
+// Slot returns the time from the start of a track's slot (including the intro
+// offset) until the next track starts.
+func (t Timing) Slot(d time.Duration) time.Duration {
+	slot := t.IntroOffset + d - t.OutroOffset - t.Crossfade
+	if slot < minSlot {
+		return minSlot
+	}
+	return slot
+}
+
+// PlaylistDuration returns the time the tracks take when played in a row,
+// until the last one has ended.
+func (t Timing) PlaylistDuration(tracks []track.Track) time.Duration {
+	if len(tracks) == 0 {
+		return 0
+	}
+
+	var total time.Duration
+	for _, tr := range tracks {
+		total += t.Slot(tr.Duration)
+	}
+	// Nothing follows the last track, so it is not cut short by the crossfade
+	return total + t.Crossfade
+}
@@ -0,0 +1,66 @@
+package playback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func TestTiming_Slot(t *testing.T) {
+	tests := []struct {
+		name     string
+		timing   Timing
+		duration time.Duration
+		want     time.Duration
+	}{
+		{name: "back to back", duration: 3 * time.Minute, want: 3 * time.Minute},
+		{name: "crossfade", timing: Timing{Crossfade: 5 * time.Second}, duration: 3 * time.Minute, want: 3*time.Minute - 5*time.Second},
+		{name: "intro offset", timing: Timing{IntroOffset: 2 * time.Second}, duration: 3 * time.Minute, want: 3*time.Minute + 2*time.Second},
+		{name: "outro offset", timing: Timing{OutroOffset: 3 * time.Second}, duration: 3 * time.Minute, want: 3*time.Minute - 3*time.Second},
+		{
+			name:     "all combined",
+			timing:   Timing{Crossfade: 5 * time.Second, IntroOffset: 2 * time.Second, OutroOffset: 3 * time.Second},
+			duration: 3 * time.Minute,
+			want:     3*time.Minute - 6*time.Second,
+		},
+		{name: "shorter than the cuts", timing: Timing{Crossfade: 10 * time.Second}, duration: 5 * time.Second, want: minSlot},
+		{name: "zero duration", duration: 0, want: minSlot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.timing.Slot(tt.duration))
+		})
+	}
+}
+
+func TestTiming_PlaylistDuration(t *testing.T) {
+	tracks := []track.Track{{Duration: 3 * time.Minute}, {Duration: 4 * time.Minute}}
+
+	tests := []struct {
+		name   string
+		timing Timing
+		tracks []track.Track
+		want   time.Duration
+	}{
+		{name: "empty", timing: Timing{Crossfade: 5 * time.Second}, want: 0},
+		{name: "back to back", tracks: tracks, want: 7 * time.Minute},
+		{name: "single track is not cut by the crossfade", timing: Timing{Crossfade: 5 * time.Second}, tracks: tracks[:1], want: 3 * time.Minute},
+		{name: "crossfade between tracks only", timing: Timing{Crossfade: 5 * time.Second}, tracks: tracks, want: 7*time.Minute - 5*time.Second},
+		{
+			name:   "offsets on every track",
+			timing: Timing{IntroOffset: 2 * time.Second, OutroOffset: 3 * time.Second},
+			tracks: tracks,
+			want:   7*time.Minute - 2*time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.timing.PlaylistDuration(tt.tracks))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Package recurrence provides recurring session schedules.
+package recurrence
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/robfig/cron/v3"
+
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+// dateLayout is the layout of holiday dates and the {date} placeholder.
+const dateLayout = "2006-01-02"
+
+// maxSkippedOccurrences bounds the search for the next non-holiday occurrence.
+const maxSkippedOccurrences = 1000
+
+// Occurrence is a single occurrence of a recurring schedule.
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Schedule computes the occurrences of a recurring session.
+type Schedule struct {
+	cron         cron.Schedule
+	duration     time.Duration
+	location     *time.Location
+	nameTemplate string
+	holidaysFile string
+}
+
+// New creates a schedule from configuration.
+func New(cfg config.RecurrenceConfig) (*Schedule, error) {
+	location, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid time_zone: %s", cfg.TimeZone)
+	}
+
+	sched, err := cron.ParseStandard(cfg.Cron)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron expression: %s", cfg.Cron)
+	}
+
+	duration, err := time.ParseDuration(cfg.Duration)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid duration: %s", cfg.Duration)
+	}
+	if duration <= 0 {
+		return nil, errors.Newf("duration must be positive: %s", cfg.Duration)
+	}
+
+	if cfg.HolidaysFile != "" {
+		if _, err := LoadHolidays(cfg.HolidaysFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Schedule{
+		cron:         sched,
+		duration:     duration,
+		location:     location,
+		nameTemplate: cfg.PlaylistName,
+		holidaysFile: cfg.HolidaysFile,
+	}, nil
+}
+
+// Duration returns the length of each occurrence.
+func (s *Schedule) Duration() time.Duration {
+	return s.duration
+}
+
+// Next returns the first occurrence that starts after the given time and does
+// not fall on a holiday. The holidays file is re-read on every call so that
+// edits take effect without a restart.
+func (s *Schedule) Next(after time.Time) (Occurrence, error) {
+	holidays := map[string]bool{}
+	if s.holidaysFile != "" {
+		h, err := LoadHolidays(s.holidaysFile)
+		if err != nil {
+			return Occurrence{}, err
+		}
+		holidays = h
+	}
+
+	t := after.In(s.location)
+	for i := 0; i < maxSkippedOccurrences; i++ {
+		t = s.cron.Next(t)
+		if t.IsZero() {
+			break
+		}
+		if holidays[t.Format(dateLayout)] {
+			continue
+		}
+		return Occurrence{Start: t, End: t.Add(s.duration)}, nil
+	}
+	return Occurrence{}, errors.New("no upcoming occurrence")
+}
+
+// PlaylistName renders the playlist name template for an occurrence.
+func (s *Schedule) PlaylistName(title string, o Occurrence) string {
+	start := o.Start.In(s.location)
+	return strings.NewReplacer(
+		"{title}", title,
+		"{date}", start.Format(dateLayout),
+		"{time}", start.Format("15:04"),
+		"{weekday}", start.Format("Mon"),
+	).Replace(s.nameTemplate)
+}
+
+// LoadHolidays reads a holidays file: one YYYY-MM-DD date per line.
+// Blank lines and text after '#' are ignored.
+func LoadHolidays(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open holidays file")
+	}
+	defer f.Close()
+
+	holidays := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if _, err := time.Parse(dateLayout, line); err != nil {
+			return nil, errors.Newf("invalid date in holidays file (line %d): %s", lineNo, line)
+		}
+		holidays[line] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read holidays file")
+	}
+	return holidays, nil
+}
@@ -0,0 +1,104 @@
+package recurrence
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	holidays := filepath.Join(t.TempDir(), "holidays.txt")
+	require.NoError(t, os.WriteFile(holidays, []byte("# national holidays\n2025-01-13 # Coming of Age Day\n\n"), 0o644))
+
+	sched, err := New(config.RecurrenceConfig{
+		Cron:         "0 12 * * 1-5",
+		Duration:     "1h",
+		TimeZone:     "Asia/Tokyo",
+		PlaylistName: "{title} {date}",
+		HolidaysFile: holidays,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		after     time.Time
+		wantStart time.Time
+	}{
+		{
+			name:      "same day before start",
+			after:     time.Date(2025, 1, 10, 9, 0, 0, 0, tokyo), // Friday
+			wantStart: time.Date(2025, 1, 10, 12, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "weekend and holiday are skipped",
+			after:     time.Date(2025, 1, 10, 12, 0, 0, 0, tokyo),
+			wantStart: time.Date(2025, 1, 14, 12, 0, 0, 0, tokyo), // Monday 13th is a holiday
+		},
+		{
+			name:      "other time zone",
+			after:     time.Date(2025, 1, 14, 2, 0, 0, 0, time.UTC), // 11:00 in Tokyo
+			wantStart: time.Date(2025, 1, 14, 12, 0, 0, 0, tokyo),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occ, err := sched.Next(tt.after)
+			require.NoError(t, err)
+			assert.True(t, tt.wantStart.Equal(occ.Start), "got start %v", occ.Start)
+			assert.True(t, tt.wantStart.Add(time.Hour).Equal(occ.End), "got end %v", occ.End)
+		})
+	}
+}
+
+func TestSchedule_PlaylistName(t *testing.T) {
+	sched, err := New(config.RecurrenceConfig{
+		Cron:         "30 12 * * *",
+		Duration:     "45m",
+		TimeZone:     "Asia/Tokyo",
+		PlaylistName: "{title} {date} {time} ({weekday})",
+	})
+	require.NoError(t, err)
+
+	occ, err := sched.Next(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, "Office Radio 2025-01-10 12:30 (Fri)", sched.PlaylistName("Office Radio", occ))
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.RecurrenceConfig
+	}{
+		{"invalid cron", config.RecurrenceConfig{Cron: "every day", Duration: "1h", TimeZone: "UTC"}},
+		{"invalid duration", config.RecurrenceConfig{Cron: "0 12 * * *", Duration: "1 hour", TimeZone: "UTC"}},
+		{"non-positive duration", config.RecurrenceConfig{Cron: "0 12 * * *", Duration: "0s", TimeZone: "UTC"}},
+		{"invalid time zone", config.RecurrenceConfig{Cron: "0 12 * * *", Duration: "1h", TimeZone: "Mars/Olympus"}},
+		{"missing holidays file", config.RecurrenceConfig{Cron: "0 12 * * *", Duration: "1h", TimeZone: "UTC", HolidaysFile: "/nonexistent"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadHolidays_InvalidDate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.txt")
+	require.NoError(t, os.WriteFile(path, []byte("2025-01-01\n2025/01/02\n"), 0o644))
+
+	_, err := LoadHolidays(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+}
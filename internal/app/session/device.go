@@ -0,0 +1,130 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/app/playback"
+	"github.com/osa030/19box/internal/infra/spotify"
+)
+
+// devicePlayer drives a Spotify Connect device of the host account.
+// The device is looked up and activated on first use, and again after a
+// command fails (e.g. the device went offline); other commands go straight
+// to the device.
+//
+// Each track is started on its own rather than added to the device's queue
+// ahead of time: the Web API can add to the queue but not remove from or
+// reorder it, so a queued track would go stale, and play out of order,
+// whenever the session queue changes (requests overtaking BGM, retracted BGM,
+// skips, the ending policy). Track changes follow the progress the device
+// reports, so the next track starts when the device reaches the end of the
+// previous one rather than on a separate wall-clock timer.
+type devicePlayer struct {
+	client *spotify.Client
+	name   string // empty uses the active device
+
+	mu       sync.Mutex
+	deviceID string
+}
+
+func newDevicePlayer(client *spotify.Client, name string) *devicePlayer {
+	return &devicePlayer{client: client, name: name}
+}
+
+// PlayTrack implements playback.Player.
+func (d *devicePlayer) PlayTrack(ctx context.Context, trackID string, position time.Duration) error {
+	return d.do(ctx, func(deviceID string) error {
+		return d.client.PlayTrack(ctx, deviceID, trackID, position)
+	})
+}
+
+// Pause implements playback.Player.
+func (d *devicePlayer) Pause(ctx context.Context) error {
+	return d.do(ctx, func(deviceID string) error {
+		return d.client.PausePlayback(ctx, deviceID)
+	})
+}
+
+// Resume implements playback.Player.
+func (d *devicePlayer) Resume(ctx context.Context) error {
+	return d.do(ctx, func(deviceID string) error {
+		return d.client.ResumePlayback(ctx, deviceID)
+	})
+}
+
+// Seek implements playback.Player.
+func (d *devicePlayer) Seek(ctx context.Context, position time.Duration) error {
+	return d.do(ctx, func(deviceID string) error {
+		return d.client.SeekPlayback(ctx, deviceID, position)
+	})
+}
+
+// State implements playback.Player.
+// Returns nil if the account is not playing on the controlled device.
+func (d *devicePlayer) State(ctx context.Context) (*playback.PlayerState, error) {
+	ps, err := d.client.GetPlaybackState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	deviceID := d.deviceID
+	d.mu.Unlock()
+	if ps == nil || ps.DeviceID != deviceID {
+		return nil, nil
+	}
+
+	return &playback.PlayerState{
+		TrackID:  ps.TrackID,
+		Progress: ps.Progress,
+		Playing:  ps.Playing,
+	}, nil
+}
+
+// do runs a command against the device, resolving it first if needed.
+func (d *devicePlayer) do(ctx context.Context, fn func(deviceID string) error) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.deviceID == "" {
+		deviceID, err := d.client.FindDevice(ctx, d.name)
+		if err != nil {
+			return err
+		}
+		if err := d.client.TransferPlayback(ctx, deviceID); err != nil {
+			return err
+		}
+		zlog.Info().Msgf("playback device activated: name=%s device_id=%s", d.name, deviceID)
+		d.deviceID = deviceID
+	}
+
+	if err := fn(d.deviceID); err != nil {
+		d.deviceID = ""
+		return err
+	}
+	return nil
+}
+
+// accountPlayback reports the host account's playback on whichever device is active.
+// It is used to detect drift when the server does not control a device.
+type accountPlayback struct {
+	client *spotify.Client
+}
+
+// State implements playback.StateSource.
+func (a accountPlayback) State(ctx context.Context) (*playback.PlayerState, error) {
+	ps, err := a.client.GetPlaybackState(ctx)
+	if err != nil || ps == nil {
+		return nil, err
+	}
+
+	return &playback.PlayerState{
+		TrackID:  ps.TrackID,
+		Progress: ps.Progress,
+		Playing:  ps.Playing,
+	}, nil
+}
@@ -0,0 +1,347 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/app/bgm"
+	"github.com/osa030/19box/internal/app/recurrence"
+	"github.com/osa030/19box/internal/infra/config"
+	"github.com/osa030/19box/internal/infra/library"
+	"github.com/osa030/19box/internal/infra/spotify"
+)
+
+// recurrenceRetryInterval is the wait before retrying a recurring session that failed to be created.
+const recurrenceRetryInterval = 1 * time.Minute
+
+var (
+	ErrNoSession             = errors.New("no session has been created")
+	ErrSessionInProgress     = errors.New("a session is already in progress in this room")
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrSessionIDRequired     = errors.New("session_id is required when multiple sessions exist")
+	ErrSessionAlreadyStarted = errors.New("session has already been started")
+)
+
+// Host runs the sessions of every room in a single server process.
+// Each room has at most one session at a time; between sessions a room is idle.
+// Sessions share the Spotify client and the BGM API clients and caches.
+type Host struct {
+	mu sync.Mutex
+
+	config     *config.Config
+	spotify    *spotify.Client
+	bgmClients *bgm.SharedClients
+
+	sessions map[string]*hostedSession // keyed by session ID
+	order    []string                  // session IDs in creation order
+
+	// Lifetime of background work such as recurring schedules
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// hostedSession is a session registered in the host.
+type hostedSession struct {
+	manager *Manager
+}
+
+// NewHost creates a new session host.
+// lib records the sessions' plays; nil disables the library.
+func NewHost(cfg *config.Config, spotifyClient *spotify.Client, lib *library.Library) *Host {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Host{
+		config:     cfg,
+		spotify:    spotifyClient,
+		bgmClients: bgm.NewSharedClients(spotifyClient, lib),
+		sessions:   make(map[string]*hostedSession),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// CreateSession creates a new session in the room given by params without starting it.
+// It fails if the room's previous session has not terminated yet.
+func (h *Host) CreateSession(ctx context.Context, params Params) (*Manager, error) {
+	roomCfg, err := h.config.ForRoom(params.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range []config.PlaylistEntryConfig{params.Opening, params.Ending} {
+		if entry.PlaylistURL == "" {
+			continue
+		}
+		if err := h.spotify.CheckPlaylistExists(ctx, entry.PlaylistURL); err != nil {
+			return nil, errors.Wrapf(err, "playlist %s is not available", entry.PlaylistURL)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Replace the room's terminated session
+	for id, hs := range h.sessions {
+		if hs.manager.Room() != params.Room {
+			continue
+		}
+		if !hs.manager.IsTerminated() {
+			return nil, ErrSessionInProgress
+		}
+		hs.manager.Close()
+		h.removeLocked(id)
+	}
+
+	m, err := NewManager(roomCfg, h.spotify, h.bgmClients, params)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID := m.SessionID()
+	h.sessions[sessionID] = &hostedSession{manager: m}
+	h.order = append(h.order, sessionID)
+	zlog.Info().Msgf("session created: session_id=%s room=%s title=%s", sessionID, params.Room, params.Title)
+	return m, nil
+}
+
+// StartSession starts a created session. The session runs in the background until it terminates.
+// See Get for how sessionID is resolved.
+func (h *Host) StartSession(sessionID string) (*Manager, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	hs, err := h.resolveLocked(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	m := hs.manager
+	if m.IsTerminated() {
+		return nil, ErrSessionNotRunning
+	}
+	if m.started.Swap(true) {
+		return nil, ErrSessionAlreadyStarted
+	}
+
+	go h.run(m)
+	return m, nil
+}
+
+// run starts the session and releases its resources once it terminates.
+func (h *Host) run(m *Manager) {
+	sessionID := m.SessionID()
+	if err := m.Start(context.Background()); err != nil {
+		zlog.Error().Msgf("failed to start session: session_id=%s error=%v", sessionID, err)
+		if err := m.StopImmediate(context.Background()); err != nil {
+			zlog.Error().Msgf("failed to stop session: session_id=%s error=%v", sessionID, err)
+		}
+	}
+
+	<-m.Done()
+	m.Close()
+	zlog.Info().Msgf("session finished, room is idle: session_id=%s room=%s", sessionID, m.Room())
+}
+
+// StartRecurring runs the room's recurrence schedule in the background: a new
+// session is created and started for each occurrence until the host is closed.
+func (h *Host) StartRecurring(room string) error {
+	roomCfg, err := h.config.ForRoom(room)
+	if err != nil {
+		return err
+	}
+	if roomCfg.Session.Recurrence == nil {
+		return errors.Newf("room %q has no recurrence schedule", room)
+	}
+
+	sched, err := recurrence.New(*roomCfg.Session.Recurrence)
+	if err != nil {
+		return err
+	}
+
+	go h.runRecurring(room, sched)
+	return nil
+}
+
+// runRecurring creates and runs one session per occurrence of the schedule.
+func (h *Host) runRecurring(room string, sched *recurrence.Schedule) {
+	var last time.Time
+	for {
+		// Include an occurrence that is already in progress (e.g. after a restart),
+		// but never repeat one that has already been held.
+		after := time.Now().Add(-sched.Duration())
+		if after.Before(last) {
+			after = last
+		}
+		occ, err := sched.Next(after)
+		if err != nil {
+			zlog.Error().Msgf("failed to compute next occurrence: room=%s error=%v", room, err)
+			if !h.sleep(recurrenceRetryInterval) {
+				return
+			}
+			continue
+		}
+
+		params, err := ParamsFromConfig(h.config, room)
+		if err != nil {
+			zlog.Error().Msgf("invalid recurring session config: room=%s error=%v", room, err)
+			return
+		}
+		params.PlaylistName = sched.PlaylistName(params.Title, occ)
+		end := occ.End
+		params.EndTime = &end
+		if occ.Start.After(time.Now()) {
+			start := occ.Start
+			params.StartTime = &start
+		}
+
+		m, err := h.CreateSession(h.ctx, params)
+		if errors.Is(err, ErrSessionInProgress) {
+			// The previous session is still running (e.g. extended); wait for it to end
+			zlog.Info().Msgf("waiting for the current session before the next occurrence: room=%s start=%v", room, occ.Start)
+			if prev := h.roomSession(room); prev != nil {
+				select {
+				case <-prev.Done():
+				case <-h.ctx.Done():
+					return
+				}
+			}
+			continue
+		}
+		if err != nil {
+			zlog.Error().Msgf("failed to create recurring session: room=%s start=%v error=%v", room, occ.Start, err)
+			if !h.sleep(recurrenceRetryInterval) {
+				return
+			}
+			continue
+		}
+		last = occ.Start
+
+		if _, err := h.StartSession(m.SessionID()); err != nil {
+			zlog.Error().Msgf("failed to start recurring session: session_id=%s error=%v", m.SessionID(), err)
+		}
+		zlog.Info().Msgf("recurring session scheduled: room=%s playlist=%s start=%v end=%v", room, params.PlaylistName, occ.Start, occ.End)
+
+		select {
+		case <-m.Done():
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
+// sleep waits for d or until the host is closed. Returns false if the host was closed.
+func (h *Host) sleep(d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-h.ctx.Done():
+		return false
+	}
+}
+
+// roomSession returns the room's session, or nil if there is none.
+func (h *Host) roomSession(room string) *Manager {
+	for _, m := range h.List() {
+		if m.Room() == room {
+			return m
+		}
+	}
+	return nil
+}
+
+// Get returns a session, which may already have terminated.
+// sessionID may be a session ID or a room name. If it is empty, the only
+// session is returned; ErrSessionIDRequired is returned if there are several.
+func (h *Host) Get(sessionID string) (*Manager, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	hs, err := h.resolveLocked(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return hs.manager, nil
+}
+
+// FindByListener returns the session the listener has joined.
+func (h *Host) FindByListener(listenerID string) (*Manager, error) {
+	for _, m := range h.List() {
+		if m.ValidateListener(listenerID) == nil {
+			return m, nil
+		}
+	}
+	return nil, ErrSessionNotFound
+}
+
+// List returns all sessions in creation order.
+func (h *Host) List() []*Manager {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	managers := make([]*Manager, 0, len(h.order))
+	for _, id := range h.order {
+		managers = append(managers, h.sessions[id].manager)
+	}
+	return managers
+}
+
+// StopImmediate immediately terminates all sessions.
+func (h *Host) StopImmediate(ctx context.Context) error {
+	var errs error
+	for _, m := range h.List() {
+		if err := m.StopImmediate(ctx); err != nil {
+			errs = errors.CombineErrors(errs, errors.Wrapf(err, "session %s", m.SessionID()))
+		}
+	}
+	return errs
+}
+
+// Close stops recurring schedules and closes all sessions.
+func (h *Host) Close() {
+	h.cancel()
+	for _, m := range h.List() {
+		m.Close()
+	}
+}
+
+// resolveLocked resolves a session ID or room name to a session.
+// Must be called with lock held.
+func (h *Host) resolveLocked(sessionID string) (*hostedSession, error) {
+	if sessionID == "" {
+		switch len(h.order) {
+		case 0:
+			return nil, ErrNoSession
+		case 1:
+			return h.sessions[h.order[0]], nil
+		default:
+			return nil, ErrSessionIDRequired
+		}
+	}
+
+	if hs, ok := h.sessions[sessionID]; ok {
+		return hs, nil
+	}
+	for _, id := range h.order {
+		if hs := h.sessions[id]; hs.manager.Room() == sessionID {
+			return hs, nil
+		}
+	}
+	if len(h.order) == 0 {
+		return nil, ErrNoSession
+	}
+	return nil, ErrSessionNotFound
+}
+
+// removeLocked removes a session from the registry.
+// Must be called with lock held.
+func (h *Host) removeLocked(sessionID string) {
+	delete(h.sessions, sessionID)
+	for i, id := range h.order {
+		if id == sessionID {
+			h.order = append(h.order[:i], h.order[i+1:]...)
+			break
+		}
+	}
+}
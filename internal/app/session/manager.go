@@ -4,12 +4,15 @@ package session
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
 
+	"github.com/osa030/19box/internal/app/audit"
 	"github.com/osa030/19box/internal/app/bgm"
 	"github.com/osa030/19box/internal/app/filter"
 	"github.com/osa030/19box/internal/app/notification"
@@ -20,21 +23,32 @@ import (
 	"github.com/osa030/19box/internal/domain/track"
 	jukeboxv1 "github.com/osa030/19box/internal/gen/jukebox/v1"
 	"github.com/osa030/19box/internal/infra/config"
+	"github.com/osa030/19box/internal/infra/library"
 	"github.com/osa030/19box/internal/infra/spotify"
 	zlog "github.com/rs/zerolog/log"
 )
 
 var (
 	ErrSessionNotRunning = errors.New("session is not running")
+	ErrSessionNotStarted = errors.New("session has not been started")
 	ErrSessionNotPaused  = errors.New("session is not paused")
+	ErrSessionEnding     = errors.New("session is already ending")
+	ErrInvalidSchedule   = errors.New("invalid schedule")
 )
 
+// maxAuditEntries is the number of recent request audit entries kept in memory.
+const maxAuditEntries = 1000
+
+// endingTimeout bounds the Spotify calls made when the session moves to the ending phase.
+const endingTimeout = 30 * time.Second
+
 // Manager manages the jukebox session.
 type Manager struct {
 	mu sync.RWMutex
 
 	// Configuration
 	config *config.Config
+	params Params
 
 	// Components
 	stateMgr     *state.Manager
@@ -43,14 +57,15 @@ type Manager struct {
 	filterChain  *filter.Chain
 	notification *notification.Manager
 	spotify      *spotify.Client
+	auditLog     *audit.Recorder
+	library      *library.Library // nil: plays are not recorded
+
+	// Time limit check repeated when a user request is enqueued
+	acceptanceFilter *filter.AcceptanceDoneFilter
 
 	// BGM provider
 	bgmProvider *bgm.ProviderChain
 
-	// Ending playlist config
-	endingPlaylistURL string
-	endingDisplayName string
-
 	// System user for system-generated tracks
 	systemUser *listener.Session
 
@@ -58,48 +73,111 @@ type Manager struct {
 	recentArtists    []string
 	maxRecentArtists int
 
+	// Set by the host when the session is started; listeners cannot join before
+	started atomic.Bool
+
+	// Schedule
+	scheduleCh          chan struct{} // wakes up Start when the start time changes
+	stopScheduleChecker context.CancelFunc
+	segments            []scheduledSegment
+	nextSegment         int // Index of the next segment to start
+
+	// BGM providers of the session, restored when a segment without its own providers starts
+	defaultBGMProvider *bgm.ProviderChain
+	// BGM candidates prefetched in the background
+	bgmPool *bgmPool
+	// Orders BGM candidates along the configured arc (nil: provider order)
+	bgmReranker *bgm.Reranker
+	// Held while the queue is topped up with BGM
+	bgmFillMu sync.Mutex
+
 	// Channels
-	ctx    context.Context
-	cancel context.CancelFunc
-	done   chan struct{}
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
-// NewManager creates a new session manager.
+// NewManager creates a new session manager for a single session.
+// cfg is the effective configuration of the session's room.
 func NewManager(
 	cfg *config.Config,
 	spotifyClient *spotify.Client,
+	bgmClients *bgm.SharedClients,
+	params Params,
 ) (*Manager, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// Create BGM provider chain
-	bgmProviderChain, err := bgm.NewProviderChainFromConfig(cfg, spotifyClient)
+	bgmProviderChain, err := bgm.NewProviderChainFromConfig(cfg, bgmClients)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to create BGM provider chain")
 	}
+	segments, err := newScheduledSegments(cfg, bgmClients)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to create segment BGM provider chain")
+	}
+	bgmReranker, err := bgm.NewReranker(cfg.BGM, bgmClients)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to create BGM arc")
+	}
 
 	sessionID := uuid.New().String()
 
+	playbackCfg := playback.Config{
+		DepletionThresholdSec: cfg.BGM.DepletionThresholdSec,
+		NotificationDelay:     time.Duration(cfg.Playback.NotificationDelayMs) * time.Millisecond,
+		GapCorrection:         time.Duration(cfg.Playback.GapCorrectionMs) * time.Millisecond,
+		Timing: playback.Timing{
+			Crossfade:   time.Duration(cfg.Playback.CrossfadeMs) * time.Millisecond,
+			IntroOffset: time.Duration(cfg.Playback.IntroOffsetMs) * time.Millisecond,
+			OutroOffset: time.Duration(cfg.Playback.OutroOffsetMs) * time.Millisecond,
+		},
+		Jingles: playback.JingleSchedule{
+			EveryTracks:  params.Jingle.EveryTracks,
+			Every:        time.Duration(params.Jingle.EveryMinutes) * time.Minute,
+			AtBoundaries: params.Jingle.AtPhaseBoundaries,
+		},
+	}
+	device, drift := cfg.Playback.Device, cfg.Playback.Drift
+	if device.Enabled {
+		playbackCfg.Player = newDevicePlayer(spotifyClient, device.Name)
+	} else if drift.Enabled {
+		playbackCfg.StateSource = accountPlayback{client: spotifyClient}
+	}
+	if device.Enabled || drift.Enabled {
+		playbackCfg.ReconcileInterval = time.Duration(drift.PollIntervalMs) * time.Millisecond
+		playbackCfg.DriftTolerance = time.Duration(drift.ToleranceMs) * time.Millisecond
+		if drift.Action == "alert" {
+			playbackCfg.DriftAction = playback.DriftAlert
+		}
+	}
+
 	m := &Manager{
-		config:      cfg,
-		stateMgr:    state.New(sessionID),
-		listenerReg: registry.NewListenerRegistry(),
-	playback: playback.NewController(playback.Config{
-			DepletionThresholdSec: cfg.BGM.DepletionThresholdSec,
-			NotificationDelay:     time.Duration(cfg.Playback.NotificationDelayMs) * time.Millisecond,
-			GapCorrection:         time.Duration(cfg.Playback.GapCorrectionMs) * time.Millisecond,
-		}),
+		config:       cfg,
+		params:       params,
+		stateMgr:     state.New(sessionID),
+		listenerReg:  registry.NewListenerRegistry(),
+		playback:     playback.NewController(playbackCfg),
 		spotify:      spotifyClient,
 		notification: notification.NewManager(),
 		filterChain:  filter.NewChain(),
+		auditLog:     audit.NewRecorder(maxAuditEntries),
+		library:      bgmClients.Library(),
 		bgmProvider:  bgmProviderChain,
 
-		endingPlaylistURL: cfg.Playlists.Ending.PlaylistURL,
-		endingDisplayName: cfg.Playlists.Ending.DisplayName,
-
 		recentArtists:    make([]string, 0),
 		maxRecentArtists: cfg.BGM.RecentArtistCount,
 
+		scheduleCh:         make(chan struct{}, 1),
+		segments:           segments,
+		defaultBGMProvider: bgmProviderChain,
+		bgmPool:            newBGMPool(),
+		bgmReranker:        bgmReranker,
+
 		ctx:    ctx,
 		cancel: cancel,
 		done:   make(chan struct{}),
@@ -115,6 +193,14 @@ func NewManager(
 	// Setup filters
 	m.setupFilters()
 
+	// The audience provider selects BGM for the listeners in this session
+	bgmProviderChain.SetAudience(m)
+	for _, seg := range segments {
+		if seg.bgm != nil {
+			seg.bgm.SetAudience(m)
+		}
+	}
+
 	return m, nil
 }
 
@@ -122,15 +208,26 @@ func NewManager(
 func (m *Manager) setupFilters() {
 	cfg := m.config
 
+	// RequestsPausedFilter
+	m.filterChain.Add(filter.NewRequestsPausedFilter(func() bool { return m.stateMgr.RequestsPaused() }))
+
 	// AcceptanceDoneFilter
-	m.filterChain.Add(filter.NewAcceptanceDoneFilter(
+	m.acceptanceFilter = filter.NewAcceptanceDoneFilter(
 		func() bool { return m.stateMgr.CanAcceptRequests() },
 		func() *time.Time { _, endTime := m.stateMgr.GetTimes(); return endTime },
 		func() time.Duration { return m.stateMgr.GetEndingDuration() },
 		func() time.Duration { return m.playback.GetTotalDuration() },
 		func() time.Duration { return m.playback.GetRemainingDuration() },
 		func() time.Time { return time.Now() },
-	))
+	)
+	if cfg.Session.RejectOverrun {
+		m.acceptanceFilter.RejectOverrun(m.playback.GetTiming().Slot)
+	}
+	if cfg.BGM.YieldsToRequests() {
+		// Requests overtake queued BGM: the time limit is checked where they are queued
+		m.acceptanceFilter.DeferTimeLimit()
+	}
+	m.filterChain.Add(m.acceptanceFilter)
 
 	// MarketFilter
 	m.filterChain.Add(filter.NewMarketFilter(cfg.Spotify.Market))
@@ -160,50 +257,79 @@ func (m *Manager) setupFilters() {
 			m.filterChain.Add(f)
 		}
 	}
+
+	// Filters tightened during the last call
+	lastCallFilters := cfg.Session.LastCall.Filters
+	names := make([]string, 0, len(lastCallFilters))
+	for name, fc := range lastCallFilters {
+		if fc.Enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		factory, ok := filter.GetRegistered()[name]
+		if !ok {
+			zlog.Error().Msgf("unknown last call filter: %s", name)
+			continue
+		}
+		f := factory()
+		if err := f.ValidateConfig(lastCallFilters[name].Settings); err != nil {
+			zlog.Error().Msgf("failed to validate last call filter config: filter=%s error=%v", name, err)
+			continue
+		}
+		m.filterChain.Add(filter.When(func() bool { return m.stateMgr.InLastCall() }, f))
+	}
 }
 
 // Start starts the session.
 func (m *Manager) Start(ctx context.Context) error {
 	m.mu.Lock()
 
-	// Parse start time
-	var startTime *time.Time
-	if parsedStartTime, err := m.config.ParseStartTime(); err == nil && parsedStartTime != nil {
-		startTime = parsedStartTime
-	}
-
-	// Parse end time
-	var endTime *time.Time
-	if parsedEndTime, err := m.config.ParseEndTime(); err == nil && parsedEndTime != nil {
-		endTime = parsedEndTime
+	m.stateMgr.SetTimes(m.params.StartTime, m.params.EndTime)
+	m.stateMgr.SetKeywords(m.params.Keywords)
+	m.defaultBGMProvider.SetKeywords(m.params.Keywords)
+	for _, seg := range m.segments {
+		if seg.bgm != nil {
+			seg.bgm.SetKeywords(m.params.Keywords)
+		}
 	}
 
-	m.stateMgr.SetTimes(startTime, endTime)
-	m.stateMgr.SetKeywords(m.config.Session.Keywords)
-
-	// Wait for start time if needed
-	if startTime != nil {
-		now := time.Now()
-		if now.Before(*startTime) {
-			waitDuration := startTime.Sub(now)
-			zlog.Info().Msgf("waiting for start time: start_time=%v wait_duration=%v", *startTime, waitDuration)
-			m.mu.Unlock()
+	// Wait for start time if needed (the start time may be postponed while waiting)
+	for {
+		startTime, _ := m.stateMgr.GetTimes()
+		if startTime == nil || !time.Now().Before(*startTime) {
+			break
+		}
 
-			select {
-			case <-time.After(waitDuration):
-			case <-ctx.Done():
-				m.mu.Lock()
-				return ctx.Err()
-			}
+		waitDuration := time.Until(*startTime)
+		zlog.Info().Msgf("waiting for start time: start_time=%v wait_duration=%v", *startTime, waitDuration)
+		m.mu.Unlock()
 
-			m.mu.Lock()
+		timer := time.NewTimer(waitDuration)
+		select {
+		case <-timer.C:
 			zlog.Info().Msgf("start time reached, starting session: start_time=%v", *startTime)
+		case <-m.scheduleCh:
+			timer.Stop()
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-m.ctx.Done():
+			timer.Stop()
+			return ErrSessionNotRunning
 		}
+
+		m.mu.Lock()
 	}
+	startTime, endTime := m.stateMgr.GetTimes()
 
 	// Create session playlist
 	createAt := time.Now().Format("2006-01-02 15:04")
-	playlistName := m.config.Session.Title
+	playlistName := m.params.PlaylistName
+	if playlistName == "" {
+		playlistName = m.params.Title
+	}
 	zlog.Info().Msgf("playlistName(config):[%s]", playlistName)
 	if playlistName == "" {
 		playlistName = fmt.Sprintf("Session(%s)", createAt)
@@ -221,45 +347,55 @@ func (m *Manager) Start(ctx context.Context) error {
 	zlog.Debug().Msgf("playlist created: playlist_id=%s playlist_url=%s name=%s", playlistID, playlistURL, playlistName)
 
 	// Load opening playlist
-	if m.config.Playlists.Opening.PlaylistURL != "" {
-		tracks, err := m.spotify.GetPlaylistTracks(ctx, m.config.Playlists.Opening.PlaylistURL)
+	if m.params.Opening.PlaylistURL != "" {
+		tracks, err := m.spotify.GetPlaylistTracks(ctx, m.params.Opening.PlaylistURL)
 		if err != nil {
 			zlog.Error().Msgf("failed to load opening playlist: %v", err)
 			m.mu.Unlock()
 			return errors.Wrap(err, "failed to load opening playlist")
 		}
 		zlog.Info().Msgf("loaded opening playlist: track_count=%d", len(tracks))
-		m.enqueuePlaylistTracks(tracks, m.config.Playlists.Opening.DisplayName, track.RequesterTypeOpening)
-		trackIDs := make([]string, len(tracks))
-		for i, t := range tracks {
-			trackIDs[i] = t.ID
-		}
-		if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, trackIDs); err != nil {
+		queued := m.enqueuePlaylistTracks(tracks, m.params.Opening.DisplayName, track.RequesterTypeOpening)
+		if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, trackIDsOf(queued)); err != nil {
 			zlog.Error().Msgf("failed to add opening tracks to session playlist: %v", err)
 			m.mu.Unlock()
 			return errors.Wrap(err, "failed to add opening tracks")
 		}
 	}
 
+	// Load jingle playlist
+	var jingleTracks []track.Track
+	if m.params.Jingle.PlaylistURL != "" {
+		tracks, err := m.spotify.GetPlaylistTracks(ctx, m.params.Jingle.PlaylistURL)
+		if err != nil {
+			zlog.Error().Msgf("failed to load jingle playlist: %v", err)
+			m.mu.Unlock()
+			return errors.Wrap(err, "failed to load jingle playlist")
+		}
+		zlog.Info().Msgf("loaded jingle playlist: track_count=%d", len(tracks))
+		m.playback.SetJingles(m.newSystemTracks(tracks, m.params.Jingle.DisplayName, track.RequesterTypeJingle))
+		jingleTracks = tracks
+	}
+
 	// Calculate ending duration
-	if m.endingPlaylistURL != "" {
-		tracks, err := m.spotify.GetPlaylistTracks(ctx, m.endingPlaylistURL)
+	if m.params.Ending.PlaylistURL != "" {
+		tracks, err := m.spotify.GetPlaylistTracks(ctx, m.params.Ending.PlaylistURL)
 		if err != nil {
 			zlog.Error().Msgf("failed to load ending playlist: %v", err)
 			m.mu.Unlock()
 			return errors.Wrap(err, "failed to load ending playlist")
 		}
-		var endingDuration time.Duration
-		for _, t := range tracks {
-			endingDuration += t.Duration
+		if m.params.Jingle.AtPhaseBoundaries && len(jingleTracks) > 0 {
+			// Leave room for the jingle that introduces the ending
+			tracks = append(tracks, longestTrack(jingleTracks))
 		}
-		m.stateMgr.SetEndingDuration(endingDuration)
+		m.stateMgr.SetEndingDuration(m.playback.GetTiming().PlaylistDuration(tracks))
 	}
 
 	m.mu.Unlock()
 
 	// If no opening playlist, fill queue with BGM before starting session
-	if m.config.Playlists.Opening.PlaylistURL == "" {
+	if m.params.Opening.PlaylistURL == "" {
 		m.fillQueueWithBGM()
 	}
 
@@ -274,7 +410,9 @@ func (m *Manager) Start(ctx context.Context) error {
 	if startTime == nil {
 		now := time.Now()
 		m.stateMgr.SetTimes(&now, endTime)
+		startTime = &now
 	}
+	m.resolveSegmentsLocked(*startTime)
 	m.mu.Unlock()
 
 	// Broadcast session started
@@ -290,11 +428,17 @@ func (m *Manager) Start(ctx context.Context) error {
 	// Start playback event loop
 	go m.playbackLoop()
 
-	// Start end time checker if needed
-	if endTime != nil {
-		go m.endTimeChecker()
+	// Keep BGM candidates ready in the background
+	if m.config.BGM.PrefetchCount > 0 {
+		go m.runBGMPrefetcher()
+		m.bgmPool.wake()
 	}
 
+	// Start schedule checker if needed
+	m.mu.Lock()
+	m.restartScheduleCheckerLocked()
+	m.mu.Unlock()
+
 	// Start playing
 	go func() {
 		if err := m.playback.Play(); err != nil {
@@ -338,7 +482,7 @@ func (m *Manager) Stop(ctx context.Context) error {
 	zlog.Info().Msgf("stopping session gracefully: session_id=%s", sessionID)
 	m.mu.Unlock()
 
-	m.transitionToEnding("admin_stop")
+	m.transitionToEnding("admin_stop", nil)
 	return nil
 }
 
@@ -407,13 +551,63 @@ func (m *Manager) StopImmediate(ctx context.Context) error {
 	return nil
 }
 
-// transitionToEnding transitions the session to ending phase.
-func (m *Manager) transitionToEnding(reason string) {
+// endingUpdate is the change to the session playlist that follows the move to
+// the ending phase.
+type endingUpdate struct {
+	playlistID string
+	removed    []track.QueuedTrack // Unplayed tracks dropped from the queue
+	added      []track.QueuedTrack // Ending tracks queued
+}
+
+// transitionToEnding transitions the session to ending phase. The ending
+// playlist is loaded and the session playlist updated without holding m.mu,
+// so that slow Spotify calls do not block requests and admin operations.
+// due is checked again under the lock, as the schedule may have changed while
+// the ending playlist was loading; nil ends the session regardless.
+func (m *Manager) transitionToEnding(reason string, due func() bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), endingTimeout)
+	defer cancel()
+
+	tracks := m.loadEndingTracks(ctx)
+
 	m.mu.Lock()
-	defer m.mu.Unlock()
+	if due != nil && !due() {
+		m.mu.Unlock()
+		zlog.Info().Msgf("ending no longer due: reason=%s", reason)
+		return
+	}
+	update, ok := m.transitionToEndingLocked(reason, tracks)
+	m.mu.Unlock()
 
+	if ok {
+		m.updateEndingPlaylist(ctx, update)
+	}
+}
+
+// loadEndingTracks loads the ending playlist, if one is configured.
+// Errors are logged: the session then ends without it.
+func (m *Manager) loadEndingTracks(ctx context.Context) []track.Track {
+	if m.params.Ending.PlaylistURL == "" {
+		return nil
+	}
+	tracks, err := m.spotify.GetPlaylistTracks(ctx, m.params.Ending.PlaylistURL)
+	if err != nil {
+		zlog.Error().Msgf("failed to load ending playlist: %v", err)
+		return nil
+	}
+	if len(tracks) == 0 {
+		zlog.Warn().Msg("ending playlist is empty")
+	}
+	return tracks
+}
+
+// transitionToEndingLocked transitions the session to ending phase and queues
+// the ending tracks. Returns the change the session playlist needs, or false
+// if the session was not active.
+// Must be called with m.mu held.
+func (m *Manager) transitionToEndingLocked(reason string, tracks []track.Track) (endingUpdate, bool) {
 	if m.stateMgr.GetPhase() != state.PhaseActive {
-		return
+		return endingUpdate{}, false
 	}
 
 	m.stateMgr.StopAccepting()
@@ -421,48 +615,98 @@ func (m *Manager) transitionToEnding(reason string) {
 	sessionID := m.stateMgr.GetSessionID()
 	zlog.Info().Msgf("phase changed: phase=ENDING session_id=%s reason=%s", sessionID, reason)
 
-	// Load ending playlist
-	if m.endingPlaylistURL != "" {
-		tracks, err := m.spotify.GetPlaylistTracks(context.Background(), m.endingPlaylistURL)
-		if err != nil {
-			zlog.Error().Msgf("failed to load ending playlist: %v", err)
-			return
-		}
+	update := endingUpdate{playlistID: m.stateMgr.GetPlaylistID()}
 
-		if len(tracks) == 0 {
-			zlog.Warn().Msg("ending playlist is empty")
-			return
+	// Make room for the ending tracks, or end by end_time without them
+	update.removed = m.settleQueueForEndingLocked()
+	zlog.Info().Msgf("removed unplayed tracks: policy=%s count=%d", m.config.Session.EndingPolicy, len(update.removed))
+	m.releaseDroppedTracks(update.removed)
+
+	// Enqueue ending tracks
+	if len(tracks) > 0 {
+		update.added = m.enqueuePlaylistTracks(tracks, m.params.Ending.DisplayName, track.RequesterTypeEnding)
+	}
+	return update, true
+}
+
+// updateEndingPlaylist applies the move to the ending phase to the session playlist.
+func (m *Manager) updateEndingPlaylist(ctx context.Context, update endingUpdate) {
+	if len(update.removed) > 0 {
+		if err := m.removeFromPlaylist(ctx, update.playlistID, update.removed); err != nil {
+			zlog.Error().Msgf("failed to remove tracks from playlist: %v", err)
+		}
+	}
+	if len(update.added) > 0 {
+		if err := m.spotify.AddTracksToPlaylist(ctx, update.playlistID, trackIDsOf(update.added)); err != nil {
+			zlog.Error().Msgf("failed to add ending tracks to playlist: %v", err)
 		}
+	}
+}
 
-		// Clear queue and add ending tracks
-		removed := m.playback.ClearQueue()
-		zlog.Info().Msgf("removed unplayed tracks: count=%d", len(removed))
+// settleQueueForEndingLocked applies the ending policy to the queued tracks and
+// returns the tracks it removed.
+// Must be called with m.mu held.
+func (m *Manager) settleQueueForEndingLocked() []track.QueuedTrack {
+	switch m.config.Session.EndingPolicy {
+	case "play_out":
+		return nil
 
-		// Remove from Spotify playlist
-		if len(removed) > 0 {
-			playlistID := m.stateMgr.GetPlaylistID()
-			removedIDs := make([]string, len(removed))
-			for i, qt := range removed {
-				removedIDs[i] = qt.Track.ID
-			}
-			if err := m.spotify.RemoveTracksFromPlaylist(context.Background(), playlistID, removedIDs); err != nil {
-				zlog.Error().Msgf("failed to remove tracks from playlist: %v", err)
-			}
+	case "fit":
+		_, endTime := m.stateMgr.GetTimes()
+		if endTime == nil {
+			return nil
 		}
+		// Time left for queued tracks once the current track and the ending are played
+		budget := time.Until(*endTime) - m.playback.GetRemainingDuration() - m.stateMgr.GetEndingDuration()
+		timing := m.playback.GetTiming()
+		return m.playback.RetainQueue(func(qt track.QueuedTrack) bool {
+			slot := timing.Slot(qt.Track.Duration)
+			if slot > budget {
+				return false
+			}
+			budget -= slot
+			return true
+		})
 
-		// Enqueue ending tracks
-		m.enqueuePlaylistTracks(tracks, m.endingDisplayName, track.RequesterTypeEnding)
+	default:
+		return m.playback.ClearQueue()
+	}
+}
 
-		// Add to Spotify playlist
-		playlistID := m.stateMgr.GetPlaylistID()
-		trackIDs := make([]string, len(tracks))
-		for i, t := range tracks {
-			trackIDs[i] = t.ID
+// releaseDroppedTracks gives the requesters of dropped tracks their request back
+// and tells each of them, and no one else, that their track will not be played.
+func (m *Manager) releaseDroppedTracks(dropped []track.QueuedTrack) {
+	var notify []track.QueuedTrack
+	for _, qt := range dropped {
+		if qt.Requester.Type != track.RequesterTypeUser {
+			continue
 		}
-		if err := m.spotify.AddTracksToPlaylist(context.Background(), playlistID, trackIDs); err != nil {
-			zlog.Error().Msgf("failed to add ending tracks to playlist: %v", err)
+		// A replayed track was already counted when it first started
+		if !qt.Replay {
+			m.DecrementPendingTracks(qt.Requester.ID)
 		}
+		notify = append(notify, qt)
 	}
+	if len(notify) == 0 {
+		return
+	}
+
+	go func() {
+		for _, qt := range notify {
+			sessionInfo := m.buildSessionInfoWithStateUnlocked()
+			trackInfo := m.buildTrackInfo(&qt, 0, m.spotify.GetTrackURL(qt.Track.ID))
+			trackInfo.State = jukeboxv1.TrackState_TRACK_STATE_DROPPED
+
+			zlog.Info().Msgf("send REQUEST_DROPPED: track_id=%s requester=%s", qt.Track.ID, qt.Requester.Name)
+			if err := m.notification.SendToListeners([]string{qt.Requester.ID}, &jukeboxv1.Notification{
+				Type:        jukeboxv1.NotificationType_NOTIFICATION_TYPE_REQUEST_DROPPED,
+				SessionInfo: sessionInfo,
+				TrackInfo:   trackInfo,
+			}); err != nil {
+				zlog.Error().Msgf("failed to send REQUEST_DROPPED: %v", err)
+			}
+		}
+	}()
 }
 
 // terminateSession performs final termination.
@@ -518,6 +762,21 @@ func (m *Manager) Done() <-chan struct{} {
 	return m.done
 }
 
+// SessionID returns the session ID.
+func (m *Manager) SessionID() string {
+	return m.stateMgr.GetSessionID()
+}
+
+// Room returns the name of the room the session belongs to.
+func (m *Manager) Room() string {
+	return m.params.Room
+}
+
+// IsTerminated reports whether the session has terminated.
+func (m *Manager) IsTerminated() bool {
+	return m.stateMgr.GetPhase() == state.PhaseTerminated
+}
+
 // Pause pauses the session.
 func (m *Manager) Pause() error {
 	if m.stateMgr.GetPhase() != state.PhaseActive {
@@ -539,11 +798,48 @@ func (m *Manager) Skip() error {
 	return m.playback.Skip()
 }
 
+// Seek moves the current track to the given position.
+func (m *Manager) Seek(position time.Duration) error {
+	return m.playback.Seek(position)
+}
+
+// Restart plays the current track again from the beginning.
+func (m *Manager) Restart() error {
+	return m.playback.Restart()
+}
+
+// Previous plays the previously played track again.
+// The replayed tracks are added to the session playlist after the current track.
+// An error is returned if the playlist could not be updated, although the
+// tracks are replayed: the playlist then no longer matches the queue.
+func (m *Manager) Previous(ctx context.Context) error {
+	requeued, next, err := m.playback.Previous()
+	if err != nil {
+		return err
+	}
+
+	playlistID := m.stateMgr.GetPlaylistID()
+	trackIDs := trackIDsOf(requeued)
+	if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, trackIDs); err != nil {
+		return errors.Wrap(err, "failed to add replayed tracks to playlist")
+	}
+	if next != nil {
+		for _, id := range trackIDs {
+			m.movePlaylistTrackBefore(ctx, playlistID, id, next.Track.ID)
+		}
+	}
+	return nil
+}
+
 // Join adds a listener to the session.
 func (m *Manager) Join(displayName, externalUserID string) (string, error) {
 	if m.stateMgr.GetPhase() == state.PhaseTerminated {
 		return "", ErrSessionNotRunning
 	}
+	// A created session may still be reconfigured or replaced until it is started
+	if !m.started.Load() {
+		return "", ErrSessionNotStarted
+	}
 
 	isVIP := m.config.IsAdminDisplayName(displayName)
 	id, err := m.listenerReg.Join(displayName, externalUserID, isVIP)
@@ -570,6 +866,17 @@ func (m *Manager) KickListener(listenerID string) error {
 	return m.listenerReg.Kick(listenerID)
 }
 
+// SubscribeListener records that a listener opened a notification stream.
+// Subscribed listeners count as present for the audience BGM provider.
+func (m *Manager) SubscribeListener(listenerID string) error {
+	return m.listenerReg.Subscribe(listenerID)
+}
+
+// UnsubscribeListener records that a listener closed a notification stream.
+func (m *Manager) UnsubscribeListener(listenerID string) {
+	m.listenerReg.Unsubscribe(listenerID)
+}
+
 // IncrementPendingTracks increments a listener's pending track count.
 func (m *Manager) IncrementPendingTracks(listenerID string) error {
 	return m.listenerReg.IncrementPending(listenerID)
@@ -584,13 +891,22 @@ func (m *Manager) DecrementPendingTracks(listenerID string) {
 func (m *Manager) RequestTrack(ctx context.Context, listenerID, trackID string) (bool, string, error) {
 	session, err := m.GetListenerSession(listenerID)
 	if err != nil {
-		zlog.Warn().Msgf("track request rejected: listener_id=%s code=invalid_listener", listenerID)
+		m.auditLog.Record(audit.Entry{
+			ListenerID: listenerID,
+			TrackID:    trackID,
+			Code:       "invalid_listener",
+		})
 		return false, "invalid_listener", nil
 	}
 
 	t, err := m.spotify.GetTrack(ctx, trackID, m.config.Spotify.Market)
 	if err != nil {
-		zlog.Warn().Msgf("track request rejected: listener_id=%s track_id=%s code=track_not_found", listenerID, trackID)
+		m.auditLog.Record(audit.Entry{
+			ListenerID:   listenerID,
+			ListenerName: session.DisplayName,
+			TrackID:      trackID,
+			Code:         "track_not_found",
+		})
 		return false, "track_not_found", nil
 	}
 
@@ -598,14 +914,60 @@ func (m *Manager) RequestTrack(ctx context.Context, listenerID, trackID string)
 		ListenerID: listenerID,
 		TrackID:    trackID,
 	}
-	result := m.filterChain.Execute(ctx, req, *t, session, track.RequesterTypeUser)
-	zlog.Info().Msgf("track request: listener=%s track=%s result=%t code=%s", session.DisplayName, t.Name, result.Accepted, result.Code)
+	result, evaluations, queued, overtaken := m.admitRequest(ctx, req, *t, session)
+	m.auditLog.Record(audit.Entry{
+		ListenerID:   listenerID,
+		ListenerName: session.DisplayName,
+		TrackID:      t.ID,
+		TrackName:    t.Name,
+		Accepted:     result.Accepted,
+		Code:         result.Code,
+		Evaluations:  evaluations,
+	})
 	if !result.Accepted {
 		return false, result.Code, nil
 	}
 
+	m.addRecentArtists(t.Artists)
+	m.bgmPool.wake()
+
+	if err := m.IncrementPendingTracks(listenerID); err != nil {
+		zlog.Error().Msgf("failed to increment pending tracks: %v", err)
+	}
+
+	playlistID := m.stateMgr.GetPlaylistID()
+	if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, trackIDsOf(queued)); err != nil {
+		zlog.Error().Msgf("failed to add track to playlist: %v", err)
+	} else if len(overtaken) > 0 {
+		m.movePlaylistTrackBefore(ctx, playlistID, t.ID, overtaken[0].Track.ID)
+	}
+	if len(overtaken) > 0 {
+		m.retractSurplusBGM(ctx)
+	}
+
+	// If playback is idle, start playing
+	if m.playback.GetState() == playback.StateIdle {
+		go func() {
+			if err := m.playback.Play(); err != nil {
+				zlog.Debug().Msgf("play after enqueue: %v", err)
+			}
+		}()
+	}
+
+	return true, "", nil
+}
+
+// admitRequest runs a user request through the filter chain and queues it if
+// accepted. Returns the decision with the filter evaluations, the tracks queued
+// (the request and any jingle before it) and the BGM tracks it overtook.
+func (m *Manager) admitRequest(ctx context.Context, req filter.TrackRequest, t track.Track, session *listener.Session) (filter.Result, []filter.Evaluation, []track.QueuedTrack, []track.QueuedTrack) {
+	result, evaluations := m.filterChain.Trace(ctx, req, t, session, track.RequesterTypeUser)
+	if !result.Accepted {
+		return result, evaluations, nil, nil
+	}
+
 	qt := track.QueuedTrack{
-		Track: *t,
+		Track: t,
 		Requester: track.Requester{
 			ID:             session.ID,
 			Name:           session.DisplayName,
@@ -614,28 +976,208 @@ func (m *Manager) RequestTrack(ctx context.Context, listenerID, trackID string)
 		},
 		AddedAt: time.Now(),
 	}
-	m.playback.Enqueue(qt)
-	m.addRecentArtists(t.Artists)
+	// Check the time limit while reserving the slot: the filters do not know
+	// which BGM tracks the request overtakes, and concurrent requests may have
+	// passed them against the same queue
+	var overtake func(track.QueuedTrack) bool
+	if m.config.BGM.YieldsToRequests() {
+		overtake = isBGM
+	}
+	queued, overtaken := m.playback.EnqueueIf(qt, overtake, func(startsIn time.Duration) bool {
+		result = m.acceptanceFilter.Admit(startsIn, t)
+		return result.Accepted
+	})
+	return result, evaluations, queued, overtaken
+}
 
-	if err := m.IncrementPendingTracks(listenerID); err != nil {
-		zlog.Error().Msgf("failed to increment pending tracks: %v", err)
+// ForceEnqueue adds a track to the queue on behalf of an admin.
+// position is the 1-based queue position (1 = next to play); 0 or a position
+// beyond the queue length appends the track. requesterLabel is shown as the
+// requester name. bypassFilters lists the filters to skip; if empty, the whole
+// filter chain is bypassed.
+// Returns the 1-based position at which the track was queued, or the rejection code.
+func (m *Manager) ForceEnqueue(ctx context.Context, trackID string, position int, requesterLabel string, bypassFilters []string) (int, string, error) {
+	phase := m.stateMgr.GetPhase()
+	if phase == state.PhaseWaiting || phase == state.PhaseTerminated {
+		return 0, "", ErrSessionNotRunning
+	}
+
+	if requesterLabel == "" {
+		requesterLabel = "Admin"
+		if len(m.config.Admin.DisplayNames) > 0 {
+			requesterLabel = m.config.Admin.DisplayNames[0]
+		}
 	}
 
+	t, err := m.spotify.GetTrack(ctx, trackID, m.config.Spotify.Market)
+	if err != nil {
+		m.auditLog.Record(audit.Entry{
+			ListenerID:   m.systemUser.ID,
+			ListenerName: requesterLabel,
+			TrackID:      trackID,
+			Code:         "track_not_found",
+			Forced:       true,
+		})
+		return 0, "track_not_found", nil
+	}
+
+	qt := track.QueuedTrack{
+		Track: *t,
+		Requester: track.Requester{
+			ID:   m.systemUser.ID,
+			Name: requesterLabel,
+			Type: track.RequesterTypeAdmin,
+		},
+		AddedAt: time.Now(),
+	}
+
+	// Run the remaining filters as a user request if only some filters are bypassed
+	var evaluations []filter.Evaluation
+	if len(bypassFilters) > 0 {
+		req := filter.TrackRequest{
+			ListenerID: m.systemUser.ID,
+			TrackID:    t.ID,
+		}
+		var result filter.Result
+		result, evaluations = m.filterChain.Without(bypassFilters...).Trace(ctx, req, *t, m.systemUser, track.RequesterTypeUser)
+		if !result.Accepted {
+			m.recordForcedRequest(qt, bypassFilters, result, evaluations)
+			return 0, result.Code, nil
+		}
+	}
+
+	index, next := m.playback.Insert(qt, position-1)
+	m.addRecentArtists(t.Artists)
+	m.recordForcedRequest(qt, bypassFilters, filter.Result{Accepted: true}, evaluations)
+	zlog.Info().Msgf("force enqueued track: track=%s position=%d requester=%s bypass=%v", t.Name, index+1, requesterLabel, bypassFilters)
+
 	playlistID := m.stateMgr.GetPlaylistID()
-	if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, []string{trackID}); err != nil {
+	if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, []string{t.ID}); err != nil {
 		zlog.Error().Msgf("failed to add track to playlist: %v", err)
+	} else if next != nil {
+		m.movePlaylistTrackBefore(ctx, playlistID, t.ID, next.Track.ID)
 	}
 
 	// If playback is idle, start playing
 	if m.playback.GetState() == playback.StateIdle {
 		go func() {
 			if err := m.playback.Play(); err != nil {
-				zlog.Debug().Msgf("play after enqueue: %v", err)
+				zlog.Debug().Msgf("play after force enqueue: %v", err)
 			}
 		}()
 	}
 
-	return true, "", nil
+	return index + 1, "", nil
+}
+
+// recordForcedRequest writes the audit entry of a force enqueue.
+func (m *Manager) recordForcedRequest(qt track.QueuedTrack, bypassFilters []string, result filter.Result, evaluations []filter.Evaluation) {
+	if !result.Accepted {
+		zlog.Info().Msgf("force enqueue rejected: track=%s bypass=%v code=%s", qt.Track.Name, bypassFilters, result.Code)
+	}
+	m.auditLog.Record(audit.Entry{
+		ListenerID:   qt.Requester.ID,
+		ListenerName: qt.Requester.Name,
+		TrackID:      qt.Track.ID,
+		TrackName:    qt.Track.Name,
+		Accepted:     result.Accepted,
+		Code:         result.Code,
+		Evaluations:  evaluations,
+		Forced:       true,
+	})
+}
+
+// movePlaylistTrackBefore moves a track just appended to the session playlist
+// to just before nextTrackID, keeping the playlist in queue order.
+// Both tracks are located by ID in the current playlist, so tracks appended
+// concurrently by other requests stay where they are.
+func (m *Manager) movePlaylistTrackBefore(ctx context.Context, playlistID, trackID, nextTrackID string) {
+	tracks, err := m.spotify.GetPlaylistTracks(ctx, playlistID)
+	if err != nil {
+		zlog.Error().Msgf("failed to load session playlist for reordering: %v", err)
+		return
+	}
+
+	from, to := playlistMovePositions(tracks, trackID, nextTrackID)
+	if from < 0 {
+		zlog.Warn().Msgf("inserted track not found in session playlist: track_id=%s", trackID)
+		return
+	}
+	if to < 0 {
+		zlog.Warn().Msgf("track not found in session playlist, leaving inserted track at the end: track_id=%s", nextTrackID)
+		return
+	}
+	if err := m.spotify.MovePlaylistTrack(ctx, playlistID, from, to); err != nil {
+		zlog.Error().Msgf("failed to reorder session playlist: %v", err)
+	}
+}
+
+// removeFromPlaylist removes tracks taken off the queue from the session playlist.
+// Jingles recur throughout the playlist, so only their last occurrences (the
+// queued copies) are removed; other tracks are removed by ID.
+func (m *Manager) removeFromPlaylist(ctx context.Context, playlistID string, removed []track.QueuedTrack) error {
+	var trackIDs []string
+	jingles := make(map[string]int)
+	for _, qt := range removed {
+		if qt.Requester.Type == track.RequesterTypeJingle {
+			jingles[qt.Track.ID]++
+		} else {
+			trackIDs = append(trackIDs, qt.Track.ID)
+		}
+	}
+
+	if len(trackIDs) > 0 {
+		if err := m.spotify.RemoveTracksFromPlaylist(ctx, playlistID, trackIDs); err != nil {
+			return err
+		}
+	}
+	if len(jingles) == 0 {
+		return nil
+	}
+
+	tracks, err := m.spotify.GetPlaylistTracks(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+	for id, count := range jingles {
+		if err := m.spotify.RemovePlaylistTrackAt(ctx, playlistID, id, lastPositions(tracks, id, count)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lastPositions returns the positions of the last count occurrences of trackID.
+func lastPositions(tracks []track.Track, trackID string, count int) []int {
+	var positions []int
+	for i := len(tracks) - 1; i >= 0 && len(positions) < count; i-- {
+		if tracks[i].ID == trackID {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// playlistMovePositions returns the position of the last occurrence of trackID
+// (the copy just appended) and of the last occurrence of nextTrackID before it.
+// A position is -1 if the track is not found.
+func playlistMovePositions(tracks []track.Track, trackID, nextTrackID string) (int, int) {
+	from := -1
+	for i := len(tracks) - 1; i >= 0; i-- {
+		if tracks[i].ID == trackID {
+			from = i
+			break
+		}
+	}
+	if from < 0 {
+		return -1, -1
+	}
+	for i := from - 1; i >= 0; i-- {
+		if tracks[i].ID == nextTrackID {
+			return from, i
+		}
+	}
+	return from, -1
 }
 
 // Status represents the current session status with all information.
@@ -648,6 +1190,7 @@ type Status struct {
 	ListenerCount int
 	SessionInfo   *jukeboxv1.SessionInfo
 	TrackInfo     *jukeboxv1.TrackInfo
+	Drift         *jukeboxv1.PlaybackDrift // nil unless drift has been measured
 }
 
 // GetStatus returns the current session status.
@@ -671,6 +1214,12 @@ func (m *Manager) GetStatus() *Status {
 		trackInfo = m.buildTrackInfoWithState(qt, pbState)
 	}
 
+	// ドリフト計測結果を構築
+	var drift *jukeboxv1.PlaybackDrift
+	if d, ok := m.playback.GetDrift(); ok {
+		drift = m.buildPlaybackDrift(d)
+	}
+
 	return &Status{
 		Phase:         currentPhase,
 		PlaybackState: pbState,
@@ -680,9 +1229,21 @@ func (m *Manager) GetStatus() *Status {
 		ListenerCount: listenerCount,
 		SessionInfo:   sessionInfo,
 		TrackInfo:     trackInfo,
+		Drift:         drift,
 	}
 }
 
+// GetRequestStats returns aggregated request statistics and the most recent
+// audit entries (up to recentLimit, newest last).
+func (m *Manager) GetRequestStats(topTracks, recentLimit int) (audit.Stats, []audit.Entry) {
+	stats := m.auditLog.Stats(topTracks)
+	entries := m.auditLog.Entries()
+	if recentLimit >= 0 && len(entries) > recentLimit {
+		entries = entries[len(entries)-recentLimit:]
+	}
+	return stats, entries
+}
+
 // ListListeners returns all listeners.
 func (m *Manager) ListListeners() []*listener.Session {
 	return m.listenerReg.All()
@@ -724,8 +1285,10 @@ func (m *Manager) handlePlaybackEvent(event playback.Event) {
 
 	case playback.EventTrackEnded:
 		// Next track is automatically played by controller
+		m.recordPlay(event.Track, false)
 
 	case playback.EventTrackSkipped:
+		m.recordPlay(event.Track, true)
 		m.onTrackSkipped(event.Track)
 
 	case playback.EventStateChanged:
@@ -736,6 +1299,82 @@ func (m *Manager) handlePlaybackEvent(event playback.Event) {
 
 	case playback.EventQueueEmpty:
 		m.onQueueEmpty()
+
+	case playback.EventDriftDetected:
+		m.onDriftDetected(event.Track, event.Drift)
+
+	case playback.EventTrackSeeked:
+		m.onTrackSeeked(event.Track)
+	}
+}
+
+// recordPlay records a finished track in the library of past sessions.
+func (m *Manager) recordPlay(qt *track.QueuedTrack, skipped bool) {
+	if m.library == nil || qt == nil {
+		return
+	}
+	m.library.Record(*qt, skipped, time.Now())
+}
+
+func (m *Manager) onTrackSeeked(qt *track.QueuedTrack) {
+	if qt == nil {
+		return
+	}
+
+	// SessionInfoを構築し、stateを設定
+	sessionInfo := m.buildSessionInfoWithStateUnlocked()
+
+	// TrackInfoを構築（残り時間はシーク後の値）
+	pbState := m.playback.GetState()
+	trackInfo := m.buildTrackInfoWithState(qt, pbState)
+
+	zlog.Info().Msgf("broadcast TRACK_SEEKED: track_id=%s remaining_seconds=%d", qt.Track.ID, trackInfo.RemainingSeconds)
+	if err := m.notification.Broadcast(&jukeboxv1.Notification{
+		Type:        jukeboxv1.NotificationType_NOTIFICATION_TYPE_CHANGE_TRACK,
+		SessionInfo: sessionInfo,
+		TrackInfo:   trackInfo,
+	}); err != nil {
+		zlog.Error().Msgf("failed to broadcast TRACK_SEEKED: %v", err)
+	}
+}
+
+// onDriftDetected alerts admins that playback drifted from the host's actual playback.
+// Admins are the listeners who joined with an admin display name; those
+// subscribed to notifications with their listener ID get DRIFT_DETECTED.
+func (m *Manager) onDriftDetected(qt *track.QueuedTrack, drift *playback.DriftMeasurement) {
+	if qt == nil || drift == nil {
+		return
+	}
+	zlog.Warn().Msgf("playback drift detected: session_id=%s track=%s drift=%v (check the host's playback or use skip/pause to recover)",
+		m.SessionID(), qt.Track.Name, drift.Drift)
+
+	var admins []string
+	for _, session := range m.listenerReg.All() {
+		if session.VIPStatus && !session.IsKicked {
+			admins = append(admins, session.ID)
+		}
+	}
+	if len(admins) == 0 {
+		return
+	}
+
+	zlog.Info().Msgf("send DRIFT_DETECTED: admin_count=%d", len(admins))
+	if err := m.notification.SendToListeners(admins, &jukeboxv1.Notification{
+		Type:      jukeboxv1.NotificationType_NOTIFICATION_TYPE_DRIFT_DETECTED,
+		TrackInfo: m.buildTrackInfoWithState(qt, m.playback.GetState()),
+		Drift:     m.buildPlaybackDrift(*drift),
+	}); err != nil {
+		zlog.Error().Msgf("failed to send DRIFT_DETECTED: %v", err)
+	}
+}
+
+// buildPlaybackDrift converts a drift measurement to its API representation.
+func (m *Manager) buildPlaybackDrift(d playback.DriftMeasurement) *jukeboxv1.PlaybackDrift {
+	tolerance := time.Duration(m.config.Playback.Drift.ToleranceMs) * time.Millisecond
+	return &jukeboxv1.PlaybackDrift{
+		DriftMs:    d.Drift.Milliseconds(),
+		MeasuredAt: d.MeasuredAt.Format(time.RFC3339),
+		Exceeded:   d.Drift > tolerance || d.Drift < -tolerance,
 	}
 }
 
@@ -744,7 +1383,20 @@ func (m *Manager) onTrackStarted(qt *track.QueuedTrack) {
 		return
 	}
 
-	m.DecrementPendingTracks(qt.Requester.ID)
+	// A replayed track was already counted when it first started
+	if !qt.Replay {
+		m.DecrementPendingTracks(qt.Requester.ID)
+	}
+
+	// The BGM seeds have changed
+	if qt.Requester.Type != track.RequesterTypeJingle {
+		m.bgmPool.wake()
+	}
+
+	// Top the BGM buffer up as the queue moves on
+	if m.bgmBufferConfigured() && m.stateMgr.GetPhase() == state.PhaseActive && m.stateMgr.IsAccepting() {
+		go m.fillQueueWithBGM()
+	}
 
 	// SessionInfoを構築し、stateを設定
 	sessionInfo := m.buildSessionInfoWithStateUnlocked()
@@ -843,14 +1495,96 @@ func (m *Manager) onQueueEmpty() {
 	}
 }
 
-// fillQueueWithBGM fills the queue with BGM tracks.
+// fillQueueWithBGM tops the queue up with BGM tracks until the BGM buffer is full.
 func (m *Manager) fillQueueWithBGM() {
-	if m.bgmProvider == nil {
+	// One fill at a time: a running fill tops the whole buffer up
+	if !m.bgmFillMu.TryLock() {
+		return
+	}
+	defer m.bgmFillMu.Unlock()
+
+	for !m.bgmBufferFull(m.playback.GetQueuedTracks()) {
+		if !m.addBGMTrack() {
+			return
+		}
+	}
+}
+
+// bgmBufferFull reports whether queue holds enough tracks for BGM to stop adding more.
+// Without a configured buffer, BGM only fills an empty queue. Queued jingles
+// count towards the buffer time but not towards its track count.
+func (m *Manager) bgmBufferFull(queue []track.QueuedTrack) bool {
+	cfg := m.config.BGM
+	if cfg.BufferTracks == 0 && cfg.BufferSec == 0 {
+		return len(queue) > 0
+	}
+
+	timing := m.playback.GetTiming()
+	var total time.Duration
+	tracks := 0
+	for _, qt := range queue {
+		total += timing.Slot(qt.Track.Duration)
+		if qt.Requester.Type != track.RequesterTypeJingle {
+			tracks++
+		}
+	}
+	return tracks >= cfg.BufferTracks && total >= time.Duration(cfg.BufferSec)*time.Second
+}
+
+// retractSurplusBGM removes the BGM tracks at the end of the queue that the BGM
+// buffer does not need, e.g. after a user request was queued ahead of them.
+func (m *Manager) retractSurplusBGM(ctx context.Context) {
+	retracted := m.playback.RetractTail(func(queue []track.QueuedTrack) bool {
+		last := queue[len(queue)-1]
+		return isBGM(last) && m.bgmBufferFull(queue[:len(queue)-1])
+	})
+	if len(retracted) == 0 {
 		return
 	}
 
+	retractedIDs := make([]string, len(retracted))
+	for i, qt := range retracted {
+		retractedIDs[i] = qt.Track.ID
+	}
+	zlog.Info().Msgf("retracted BGM tracks: count=%d", len(retracted))
+	if err := m.spotify.RemoveTracksFromPlaylist(ctx, m.stateMgr.GetPlaylistID(), retractedIDs); err != nil {
+		zlog.Error().Msgf("failed to remove retracted BGM from playlist: %v", err)
+	}
+}
+
+// isBGM reports whether a track was queued by BGM.
+func isBGM(qt track.QueuedTrack) bool {
+	return qt.Requester.Type == track.RequesterTypeBGM
+}
+
+// bgmBufferConfigured reports whether BGM keeps a buffer of queued tracks, topped up
+// as tracks start, rather than filling the queue only when it runs out.
+func (m *Manager) bgmBufferConfigured() bool {
+	return m.config.BGM.BufferTracks > 0 || m.config.BGM.BufferSec > 0
+}
+
+// addBGMTrack adds one BGM track to the queue.
+// Returns false if no track was added, or the buffer filled up meanwhile.
+func (m *Manager) addBGMTrack() bool {
+	// The providers change when a segment starts
+	m.mu.RLock()
+	bgmProvider := m.bgmProvider
+	m.mu.RUnlock()
+	if bgmProvider == nil {
+		return false
+	}
+
 	const maxRetries = 3
 
+	// Serve from the prefetched pool if it has a suitable candidate
+	if c, ok := m.takePrefetchedBGM(context.Background()); ok {
+		if m.stateMgr.GetPhase() != state.PhaseActive || !m.stateMgr.IsAccepting() || m.bgmBufferFull(m.playback.GetQueuedTracks()) {
+			return false
+		}
+		m.enqueueBGM(c)
+		return true
+	}
+
 	// Get existing track IDs
 	existingIDs := m.playback.GetAllTrackIDs()
 	excludeSet := make(map[string]bool)
@@ -859,36 +1593,36 @@ func (m *Manager) fillQueueWithBGM() {
 	}
 
 	// Get seed tracks
-	seedTracks := m.getRecentTracks(3)
+	seedTracks := m.getRecentTracks(bgmProvider.SeedCount(m.config.BGM.SeedTrackCount))
 
 	for retry := 0; retry < maxRetries; retry++ {
-		candidates, err := m.bgmProvider.GetCandidates(context.Background(), 5, seedTracks, excludeSet)
+		candidates, err := bgmProvider.GetCandidates(context.Background(), m.config.BGM.CandidateCount, seedTracks, excludeSet)
 		if err != nil {
 			zlog.Error().Msgf("failed to get BGM candidates: %v", err)
-			return
+			return false
 		}
+		candidates = m.rankBGMCandidates(context.Background(), candidates)
 
 		if len(candidates) == 0 {
 			zlog.Warn().Msg("no BGM candidates")
-			return
+			return false
 		}
 
 		// Session state might have changed (e.g., ENDING) while fetching candidates.
 		if m.stateMgr.GetPhase() != state.PhaseActive || !m.stateMgr.IsAccepting() {
 			zlog.Debug().Msg("skipping BGM enqueue due to state change after candidate fetch")
-			return
+			return false
 		}
 
 		// Filter by recent artists
 		filtered := m.filterByRecentArtists(candidates)
 
-
 		// Process candidates
 		for _, c := range filtered {
-			// Check if queue became non-empty during selection (e.g. user added a track)
-			if !m.playback.IsQueueEmpty() {
-				zlog.Info().Msg("skipping BGM enqueue: queue is no longer empty")
-				return
+			// Check if the buffer filled up during selection (e.g. user added a track)
+			if m.bgmBufferFull(m.playback.GetQueuedTracks()) {
+				zlog.Info().Msg("skipping BGM enqueue: queue is already filled")
+				return false
 			}
 
 			if m.playback.IsInQueue(c.Track.ID) {
@@ -896,47 +1630,15 @@ func (m *Manager) fillQueueWithBGM() {
 				continue
 			}
 
-			req := filter.TrackRequest{
-				ListenerID: m.systemUser.ID,
-				TrackID:    c.Track.ID,
-			}
-			result := m.filterChain.Execute(context.Background(), req, c.Track, m.systemUser, track.RequesterTypeBGM)
+			result := m.checkBGMCandidate(context.Background(), c)
 			if !result.Accepted {
 				zlog.Debug().Msgf("BGM candidate rejected by filter: track_id=%s name=%s reason=%s", c.Track.ID, c.Track.Name, result.Code)
 				excludeSet[c.Track.ID] = true
 				continue
 			}
 
-			qt := track.QueuedTrack{
-				Track: c.Track,
-				Requester: track.Requester{
-					ID:   m.systemUser.ID,
-					Name: c.DisplayName,
-					Type: track.RequesterTypeBGM,
-				},
-				AddedAt: time.Now(),
-			}
-			m.playback.Enqueue(qt)
-
-			playlistID := m.stateMgr.GetPlaylistID()
-			if err := m.spotify.AddTracksToPlaylist(context.Background(), playlistID, []string{c.Track.ID}); err != nil {
-				zlog.Error().Msgf("failed to add BGM to playlist: %v", err)
-			}
-
-			zlog.Info().Msgf("added BGM track: track_id=%s name=%s", c.Track.ID, c.Track.Name)
-			m.addRecentArtists(c.Track.Artists)
-
-			// If idle, start playing
-			if m.playback.GetState() == playback.StateIdle {
-				go func() {
-					if err := m.playback.Play(); err != nil {
-						zlog.Debug().Msgf("play after BGM: %v", err)
-					}
-				}()
-			}
-
-			// Add one track at a time
-			return
+			m.enqueueBGM(c)
+			return true
 		}
 
 		// All candidates were filtered out, add them to exclude set and retry
@@ -947,6 +1649,38 @@ func (m *Manager) fillQueueWithBGM() {
 	}
 
 	zlog.Warn().Msg("no suitable BGM candidates after filtering")
+	return false
+}
+
+// enqueueBGM queues a BGM candidate, adds it to the session playlist and starts playing if idle.
+func (m *Manager) enqueueBGM(c bgm.CandidateWithSource) {
+	qt := track.QueuedTrack{
+		Track: c.Track,
+		Requester: track.Requester{
+			ID:   m.systemUser.ID,
+			Name: c.DisplayName,
+			Type: track.RequesterTypeBGM,
+		},
+		AddedAt: time.Now(),
+	}
+	queued := m.playback.Enqueue(qt)
+
+	playlistID := m.stateMgr.GetPlaylistID()
+	if err := m.spotify.AddTracksToPlaylist(context.Background(), playlistID, trackIDsOf(queued)); err != nil {
+		zlog.Error().Msgf("failed to add BGM to playlist: %v", err)
+	}
+
+	zlog.Info().Msgf("added BGM track: track_id=%s name=%s", c.Track.ID, c.Track.Name)
+	m.addRecentArtists(c.Track.Artists)
+
+	// If idle, start playing
+	if m.playback.GetState() == playback.StateIdle {
+		go func() {
+			if err := m.playback.Play(); err != nil {
+				zlog.Debug().Msgf("play after BGM: %v", err)
+			}
+		}()
+	}
 }
 
 func (m *Manager) filterByRecentArtists(candidates []bgm.CandidateWithSource) []bgm.CandidateWithSource {
@@ -1003,54 +1737,319 @@ func (m *Manager) addRecentArtistsLocked(artists []string) {
 	}
 }
 
+// getRecentTracks returns up to count seed tracks for BGM recommendations,
+// chosen from the queued requests and the play history by the seed policy.
 func (m *Manager) getRecentTracks(count int) []track.Track {
-	var recent []track.Track
+	var recent []track.QueuedTrack
+
+	// Queued requests first: they show where the session is heading
+	for _, qt := range m.playback.GetQueuedTracks() {
+		if qt.Requester.Type == track.RequesterTypeUser || qt.Requester.Type == track.RequesterTypeAdmin {
+			recent = append(recent, qt)
+		}
+	}
 
 	if qt, ok := m.playback.GetCurrentTrack(); ok {
-		recent = append(recent, qt.Track)
+		recent = append(recent, *qt)
 	}
 
-	if len(recent) > count {
-		recent = recent[:count]
+	played := m.playback.GetPlayedTracks()
+	for i := len(played) - 1; i >= 0; i-- {
+		recent = append(recent, played[i])
 	}
 
-	return recent
+	policy := bgm.SeedPolicy{
+		UserWeight: m.config.BGM.SeedUserWeight,
+		Decay:      m.config.BGM.SeedDecay,
+	}
+	return policy.SelectSeeds(recent, count)
 }
 
-// endTimeChecker checks if the end time has been reached.
-func (m *Manager) endTimeChecker() {
+// AudienceTracks returns the tracks requested by each listener who is in the
+// session, most recent first. Kicked listeners and listeners who left are excluded.
+// Implements bgm.AudienceSource.
+func (m *Manager) AudienceTracks(activeWithin time.Duration) map[string][]track.Track {
+	present := make(map[string]bool)
+	for _, id := range m.listenerReg.Present(activeWithin, time.Now()) {
+		present[id] = true
+	}
+	if len(present) == 0 {
+		return nil
+	}
+
+	var requested []track.QueuedTrack
+	queued := m.playback.GetQueuedTracks()
+	for i := len(queued) - 1; i >= 0; i-- {
+		requested = append(requested, queued[i])
+	}
+	if qt, ok := m.playback.GetCurrentTrack(); ok {
+		requested = append(requested, *qt)
+	}
+	played := m.playback.GetPlayedTracks()
+	for i := len(played) - 1; i >= 0; i-- {
+		requested = append(requested, played[i])
+	}
+
+	result := make(map[string][]track.Track)
+	for _, qt := range requested {
+		if qt.Requester.Type == track.RequesterTypeUser && present[qt.Requester.ID] {
+			result[qt.Requester.ID] = append(result[qt.Requester.ID], qt.Track)
+		}
+	}
+	return result
+}
+
+// rankBGMCandidates orders BGM candidates along the configured arc, closest to
+// the session's current point first. The order is kept if no arc is configured
+// or the session has no end time.
+func (m *Manager) rankBGMCandidates(ctx context.Context, candidates []bgm.CandidateWithSource) []bgm.CandidateWithSource {
+	if m.bgmReranker == nil {
+		return candidates
+	}
+	startTime, endTime := m.stateMgr.GetTimes()
+	if startTime == nil || endTime == nil || !endTime.After(*startTime) {
+		return candidates
+	}
+
+	progress := float64(time.Since(*startTime)) / float64(endTime.Sub(*startTime))
+	progress = min(max(progress, 0), 1)
+	return m.bgmReranker.Rerank(ctx, candidates, progress)
+}
+
+// scheduleChecker starts segments when their time comes and checks if the
+// acceptance deadline has been reached.
+// It stops when ctx is cancelled, which happens when the schedule changes,
+// or when nothing is left to schedule.
+func (m *Manager) scheduleChecker(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-m.ctx.Done():
+		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case now := <-ticker.C:
+			m.mu.Lock()
+			// Re-check under the lock: the schedule may have changed meanwhile
+			if ctx.Err() != nil {
+				m.mu.Unlock()
+				return
+			}
+			var segment config.SegmentConfig
+			segmentStarted := false
+			if m.stateMgr.GetPhase() == state.PhaseActive {
+				segment, segmentStarted = m.startDueSegmentLocked(now)
+			}
+			lastCallStarted := false
+			if m.lastCallDueLocked(now) && !m.stateMgr.InLastCall() {
+				m.stateMgr.SetLastCall(true)
+				zlog.Info().Msgf("last call started: lead=%dm", m.config.Session.LastCall.LeadMinutes)
+				lastCallStarted = true
+			}
+			if deadline, reached := m.acceptanceDeadlineReachedLocked(); reached {
+				zlog.Info().Msgf("acceptance deadline reached: deadline=%v", deadline)
+				m.mu.Unlock()
+				m.transitionToEnding("acceptance_deadline_reached", m.acceptanceDeadlineDueLocked)
+				return
+			}
 			_, endTime := m.stateMgr.GetTimes()
-			phase := m.stateMgr.GetPhase()
+			done := endTime == nil && !m.hasPendingSegmentsLocked()
+			m.mu.Unlock()
 
-			if endTime == nil || phase != state.PhaseActive {
-				continue
+			if segmentStarted {
+				if segment.Playlist.PlaylistURL != "" {
+					m.queueSegmentPlaylist(segment)
+				}
+				m.broadcastSegmentStarted()
 			}
-
-			endingDuration := m.stateMgr.GetEndingDuration()
-			deadline := endTime.Add(-endingDuration)
-			now := time.Now()
-
-			if now.After(deadline) || now.Equal(deadline) {
-				zlog.Info().Msgf("acceptance deadline reached: deadline=%v", deadline)
-				m.transitionToEnding("acceptance_deadline_reached")
+			if lastCallStarted {
+				m.broadcastLastCall()
+			}
+			if done {
 				return
 			}
 		}
 	}
 }
 
+// acceptanceDeadlineReachedLocked returns the acceptance deadline (end time
+// minus the ending playlist duration) and whether an active session has reached it.
+// Must be called with m.mu held.
+func (m *Manager) acceptanceDeadlineReachedLocked() (time.Time, bool) {
+	_, endTime := m.stateMgr.GetTimes()
+	if endTime == nil || m.stateMgr.GetPhase() != state.PhaseActive {
+		return time.Time{}, false
+	}
+
+	deadline := endTime.Add(-m.stateMgr.GetEndingDuration())
+	return deadline, !time.Now().Before(deadline)
+}
+
+// acceptanceDeadlineDueLocked reports whether an active session has reached its acceptance deadline.
+// Must be called with m.mu held.
+func (m *Manager) acceptanceDeadlineDueLocked() bool {
+	_, reached := m.acceptanceDeadlineReachedLocked()
+	return reached
+}
+
+// lastCallDueLocked returns true if an active session is within the last call
+// lead time of its acceptance deadline.
+// Must be called with m.mu held.
+func (m *Manager) lastCallDueLocked(now time.Time) bool {
+	lead := time.Duration(m.config.Session.LastCall.LeadMinutes) * time.Minute
+	_, endTime := m.stateMgr.GetTimes()
+	if lead <= 0 || endTime == nil || m.stateMgr.GetPhase() != state.PhaseActive {
+		return false
+	}
+
+	deadline := endTime.Add(-m.stateMgr.GetEndingDuration())
+	return !now.Before(deadline.Add(-lead))
+}
+
+// broadcastLastCall tells listeners that requests will close soon.
+func (m *Manager) broadcastLastCall() {
+	sessionInfo := m.buildSessionInfoWithStateUnlocked()
+	zlog.Info().Msgf("broadcast LAST_CALL: remaining_seconds=%d", sessionInfo.LastCallRemainingSeconds)
+	if err := m.notification.Broadcast(&jukeboxv1.Notification{
+		Type:        jukeboxv1.NotificationType_NOTIFICATION_TYPE_LAST_CALL,
+		SessionInfo: sessionInfo,
+	}); err != nil {
+		zlog.Error().Msgf("failed to broadcast LAST_CALL: %v", err)
+	}
+}
+
+// restartScheduleCheckerLocked stops the running schedule checker and starts a
+// new one if the session is active and an end time is set or segments are pending.
+// Must be called with m.mu held.
+func (m *Manager) restartScheduleCheckerLocked() {
+	if m.stopScheduleChecker != nil {
+		m.stopScheduleChecker()
+		m.stopScheduleChecker = nil
+	}
+
+	// The deadline may have moved away
+	if !m.lastCallDueLocked(time.Now()) {
+		m.stateMgr.SetLastCall(false)
+	}
+
+	_, endTime := m.stateMgr.GetTimes()
+	if (endTime == nil && !m.hasPendingSegmentsLocked()) || m.stateMgr.GetPhase() != state.PhaseActive {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.stopScheduleChecker = cancel
+	go m.scheduleChecker(ctx)
+}
+
+// UpdateSchedule changes the session schedule at runtime.
+// startTime postpones (or advances) the start of a session that has not started yet.
+// endTime extends or shortens the session; clearEndTime removes the end time so
+// that the session only ends when stopped. nil values leave the time unchanged.
+// The acceptance deadline is recomputed and the new schedule is broadcast.
+func (m *Manager) UpdateSchedule(startTime, endTime *time.Time, clearEndTime bool) error {
+	m.mu.Lock()
+
+	phase := m.stateMgr.GetPhase()
+	switch phase {
+	case state.PhaseTerminated:
+		m.mu.Unlock()
+		return ErrSessionNotRunning
+	case state.PhaseEnding:
+		m.mu.Unlock()
+		return ErrSessionEnding
+	}
+
+	now := time.Now()
+	curStart, curEnd := m.stateMgr.GetTimes()
+	if phase == state.PhaseWaiting {
+		curStart, curEnd = m.params.StartTime, m.params.EndTime
+	}
+
+	newStart := curStart
+	if startTime != nil {
+		if phase != state.PhaseWaiting {
+			m.mu.Unlock()
+			return errors.Wrap(ErrInvalidSchedule, "start_time can only be changed before the session starts")
+		}
+		if !startTime.After(now) {
+			m.mu.Unlock()
+			return errors.Wrapf(ErrInvalidSchedule, "start_time (%s) must be in the future", startTime.Format(time.RFC3339))
+		}
+		newStart = startTime
+	}
+
+	newEnd := curEnd
+	if clearEndTime {
+		newEnd = nil
+	} else if endTime != nil {
+		if !endTime.After(now) {
+			m.mu.Unlock()
+			return errors.Wrapf(ErrInvalidSchedule, "end_time (%s) must be in the future", endTime.Format(time.RFC3339))
+		}
+		newEnd = endTime
+	}
+
+	if newStart != nil && newEnd != nil && !newStart.Before(*newEnd) {
+		m.mu.Unlock()
+		return errors.Wrapf(ErrInvalidSchedule, "start_time (%s) must be before end_time (%s)",
+			newStart.Format(time.RFC3339), newEnd.Format(time.RFC3339))
+	}
+
+	sessionID := m.stateMgr.GetSessionID()
+	zlog.Info().Msgf("schedule updated: session_id=%s start_time=%v end_time=%v", sessionID, newStart, newEnd)
+
+	endNow := false
+	if phase == state.PhaseWaiting {
+		// Start reads the schedule from params; wake it up if it is waiting for the start time
+		m.params.StartTime = newStart
+		m.params.EndTime = newEnd
+		m.stateMgr.SetTimes(newStart, newEnd)
+		select {
+		case m.scheduleCh <- struct{}{}:
+		default:
+		}
+	} else {
+		m.stateMgr.SetTimes(curStart, newEnd)
+		if deadline, reached := m.acceptanceDeadlineReachedLocked(); reached {
+			zlog.Info().Msgf("acceptance deadline already passed: deadline=%v", deadline)
+			endNow = true
+		}
+		m.restartScheduleCheckerLocked()
+	}
+	m.mu.Unlock()
+
+	if endNow {
+		m.transitionToEnding("schedule_updated", m.acceptanceDeadlineDueLocked)
+	}
+
+	// Broadcast the new schedule
+	sessionInfo := m.buildSessionInfoWithStateUnlocked()
+	zlog.Info().Msgf("broadcast SCHEDULE_UPDATED: session_id=%s", sessionID)
+	if err := m.notification.Broadcast(&jukeboxv1.Notification{
+		Type:        jukeboxv1.NotificationType_NOTIFICATION_TYPE_SCHEDULE_UPDATED,
+		SessionInfo: sessionInfo,
+	}); err != nil {
+		zlog.Error().Msgf("failed to broadcast SCHEDULE_UPDATED: %v", err)
+	}
+
+	return nil
+}
+
 // enqueuePlaylistTracks enqueues tracks from a playlist.
-func (m *Manager) enqueuePlaylistTracks(tracks []track.Track, requesterName string, requesterType track.RequesterType) {
+// Returns the tracks queued, including the jingles interleaved with them.
+func (m *Manager) enqueuePlaylistTracks(tracks []track.Track, requesterName string, requesterType track.RequesterType) []track.QueuedTrack {
 	for _, t := range tracks {
-		qt := track.QueuedTrack{
+		m.addRecentArtistsLocked(t.Artists)
+	}
+	return m.playback.EnqueueMultiple(m.newSystemTracks(tracks, requesterName, requesterType))
+}
+
+// newSystemTracks wraps playlist tracks as tracks requested by the system user.
+func (m *Manager) newSystemTracks(tracks []track.Track, requesterName string, requesterType track.RequesterType) []track.QueuedTrack {
+	qts := make([]track.QueuedTrack, len(tracks))
+	for i, t := range tracks {
+		qts[i] = track.QueuedTrack{
 			Track: t,
 			Requester: track.Requester{
 				ID:   m.systemUser.ID,
@@ -1059,16 +2058,37 @@ func (m *Manager) enqueuePlaylistTracks(tracks []track.Track, requesterName stri
 			},
 			AddedAt: time.Now(),
 		}
-		m.playback.Enqueue(qt)
-		m.addRecentArtistsLocked(t.Artists)
 	}
+	return qts
+}
+
+// trackIDsOf returns the IDs of queued tracks, in order.
+func trackIDsOf(qts []track.QueuedTrack) []string {
+	ids := make([]string, len(qts))
+	for i, qt := range qts {
+		ids[i] = qt.Track.ID
+	}
+	return ids
 }
 
-// Close closes the session manager.
+// longestTrack returns the longest of the tracks.
+func longestTrack(tracks []track.Track) track.Track {
+	longest := tracks[0]
+	for _, t := range tracks[1:] {
+		if t.Duration > longest.Duration {
+			longest = t
+		}
+	}
+	return longest
+}
+
+// Close closes the session manager. It is safe to call more than once.
 func (m *Manager) Close() {
-	m.cancel()
-	m.playback.Close()
-	m.notification.Close()
+	m.closeOnce.Do(func() {
+		m.cancel()
+		m.playback.Close()
+		m.notification.Close()
+	})
 }
 
 // buildTrackInfo creates a TrackInfo from a QueuedTrack.
@@ -1122,6 +2142,7 @@ func (m *Manager) buildSessionInfoWithStateUnlocked() *jukeboxv1.SessionInfo {
 	}
 
 	sessionInfo.State = protoState
+	sessionInfo.Room = m.params.Room
 	return sessionInfo
 }
 
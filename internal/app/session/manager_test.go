@@ -0,0 +1,153 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/app/filter"
+	"github.com/osa030/19box/internal/app/playback"
+	"github.com/osa030/19box/internal/app/session/registry"
+	"github.com/osa030/19box/internal/app/session/state"
+	"github.com/osa030/19box/internal/domain/listener"
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+func playlistOf(ids ...string) []track.Track {
+	tracks := make([]track.Track, len(ids))
+	for i, id := range ids {
+		tracks[i] = track.Track{ID: id}
+	}
+	return tracks
+}
+
+func TestPlaylistMovePositions(t *testing.T) {
+	tests := []struct {
+		name     string
+		playlist []track.Track
+		trackID  string
+		nextID   string
+		wantFrom int
+		wantTo   int
+	}{
+		{name: "inserted track is last", playlist: playlistOf("a", "b", "c", "x"), trackID: "x", nextID: "b", wantFrom: 3, wantTo: 1},
+		{name: "concurrent append after inserted track", playlist: playlistOf("a", "b", "x", "y"), trackID: "x", nextID: "b", wantFrom: 2, wantTo: 1},
+		{name: "last copy of a repeated track", playlist: playlistOf("x", "a", "b", "x"), trackID: "x", nextID: "a", wantFrom: 3, wantTo: 1},
+		{name: "next track played earlier only", playlist: playlistOf("a", "x", "a"), trackID: "x", nextID: "a", wantFrom: 1, wantTo: 0},
+		{name: "next track missing", playlist: playlistOf("a", "x"), trackID: "x", nextID: "b", wantFrom: 1, wantTo: -1},
+		{name: "inserted track missing", playlist: playlistOf("a", "b"), trackID: "x", nextID: "a", wantFrom: -1, wantTo: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := playlistMovePositions(tt.playlist, tt.trackID, tt.nextID)
+			assert.Equal(t, tt.wantFrom, from)
+			assert.Equal(t, tt.wantTo, to)
+		})
+	}
+}
+
+func TestManager_Join_NotStarted(t *testing.T) {
+	m := &Manager{
+		config:      &config.Config{},
+		stateMgr:    state.New("session"),
+		listenerReg: registry.NewListenerRegistry(),
+	}
+
+	_, err := m.Join("Alice", "")
+	assert.ErrorIs(t, err, ErrSessionNotStarted)
+
+	m.started.Store(true)
+	id, err := m.Join("Alice", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+}
+
+func TestManager_AdmitRequest_FullBGMBufferNearDeadline(t *testing.T) {
+	no := false
+
+	tests := []struct {
+		name       string
+		bgm        config.BGMConfig
+		wantCode   string
+		wantQueue  []string
+		wantPassed []string // BGM tracks overtaken
+	}{
+		{
+			name:       "requests overtake the BGM",
+			wantQueue:  []string{"request", "bgm1", "bgm2", "bgm3"},
+			wantPassed: []string{"bgm1", "bgm2", "bgm3"},
+		},
+		{
+			name:       "requests queue after the BGM",
+			bgm:        config.BGMConfig{YieldToRequests: &no},
+			wantCode:   "time_limit_exceeded",
+			wantQueue:  []string{"bgm1", "bgm2", "bgm3"},
+			wantPassed: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := playback.NewController(playback.Config{})
+			t.Cleanup(c.Close)
+			m := &Manager{
+				config:      &config.Config{BGM: tt.bgm},
+				stateMgr:    state.New("session"),
+				playback:    c,
+				filterChain: filter.NewChain(),
+			}
+			m.setupFilters()
+			m.stateMgr.SetPhase(state.PhaseActive)
+			m.stateMgr.SetAccepting(state.Accepting)
+
+			// The BGM buffer runs past the deadline (end time minus the ending)
+			endTime := time.Now().Add(15 * time.Minute)
+			m.stateMgr.SetTimes(nil, &endTime)
+			m.stateMgr.SetEndingDuration(5 * time.Minute)
+			for _, id := range []string{"bgm1", "bgm2", "bgm3"} {
+				c.Enqueue(track.QueuedTrack{
+					Track:     track.Track{ID: id, Duration: 5 * time.Minute},
+					Requester: track.Requester{Type: track.RequesterTypeBGM},
+				})
+			}
+
+			req := filter.TrackRequest{ListenerID: "l1", TrackID: "request"}
+			requested := track.Track{ID: "request", Duration: 3 * time.Minute}
+			result, _, queued, overtaken := m.admitRequest(context.Background(), req, requested, listener.NewSession("l1", "Alice", "", false))
+
+			assert.Equal(t, tt.wantCode == "", result.Accepted)
+			assert.Equal(t, tt.wantCode, result.Code)
+			if tt.wantCode == "" {
+				assert.Equal(t, []string{"request"}, trackIDsOf(queued))
+			}
+			assert.Equal(t, tt.wantPassed, trackIDsOf(overtaken))
+
+			assert.Equal(t, tt.wantQueue, trackIDsOf(c.GetQueuedTracks()))
+		})
+	}
+}
+
+func TestLastPositions(t *testing.T) {
+	tests := []struct {
+		name     string
+		playlist []track.Track
+		count    int
+		want     []int
+	}{
+		{name: "last occurrence only", playlist: playlistOf("j", "a", "j", "b"), count: 1, want: []int{2}},
+		{name: "last occurrences", playlist: playlistOf("j", "a", "j", "b", "j"), count: 2, want: []int{4, 2}},
+		{name: "fewer occurrences than count", playlist: playlistOf("a", "j"), count: 3, want: []int{1}},
+		{name: "missing", playlist: playlistOf("a", "b"), count: 1, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lastPositions(tt.playlist, "j", tt.count))
+		})
+	}
+}
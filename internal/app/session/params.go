@@ -0,0 +1,82 @@
+package session
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+// Params holds the settings that vary from one session to the next.
+type Params struct {
+	Room         string // empty if no rooms are configured
+	Title        string
+	PlaylistName string     // overrides the title as the session playlist name
+	StartTime    *time.Time // nil starts the session immediately
+	EndTime      *time.Time // nil means the session only ends when stopped
+	Keywords     []string
+	Opening      config.PlaylistEntryConfig
+	Ending       config.PlaylistEntryConfig
+	Jingle       config.JingleConfig
+}
+
+// ParamsFromConfig builds session parameters for a room from its session and
+// playlists configuration.
+func ParamsFromConfig(cfg *config.Config, room string) (Params, error) {
+	roomCfg, err := cfg.ForRoom(room)
+	if err != nil {
+		return Params{}, err
+	}
+
+	startTime, err := roomCfg.ParseStartTime()
+	if err != nil {
+		return Params{}, err
+	}
+	endTime, err := roomCfg.ParseEndTime()
+	if err != nil {
+		return Params{}, err
+	}
+
+	return Params{
+		Room:      room,
+		Title:     roomCfg.Session.Title,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Keywords:  roomCfg.Session.Keywords,
+		Opening:   roomCfg.Playlists.Opening,
+		Ending:    roomCfg.Playlists.Ending,
+		Jingle:    roomCfg.Playlists.Jingle,
+	}, nil
+}
+
+// NewParams builds session parameters for a room from RFC3339 time strings (empty if unset).
+// The times must not be in the past and start must be before end.
+func NewParams(room, title, start, end string, keywords []string, opening, ending config.PlaylistEntryConfig) (Params, error) {
+	if err := config.ValidateSessionTimes(start, end); err != nil {
+		return Params{}, err
+	}
+
+	params := Params{
+		Room:     room,
+		Title:    title,
+		Keywords: keywords,
+		Opening:  opening,
+		Ending:   ending,
+	}
+	if start != "" {
+		t, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return Params{}, errors.Wrap(err, "failed to parse start_time")
+		}
+		params.StartTime = &t
+	}
+	if end != "" {
+		t, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return Params{}, errors.Wrap(err, "failed to parse end_time")
+		}
+		params.EndTime = &t
+	}
+	return params, nil
+}
@@ -0,0 +1,184 @@
+package session
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/app/bgm"
+	"github.com/osa030/19box/internal/app/filter"
+	"github.com/osa030/19box/internal/app/session/state"
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// bgmPrefetchInterval is how often the prefetcher tops up the pool when nothing wakes it.
+const bgmPrefetchInterval = 30 * time.Second
+
+// bgmPool holds BGM candidates fetched in the background, so that the queue can be
+// refilled without waiting for the providers. The candidates have already passed
+// the filter chain; they are checked again when taken, as the session moves on.
+type bgmPool struct {
+	mu         sync.Mutex
+	candidates []bgm.CandidateWithSource
+	provider   *bgm.ProviderChain // Providers the candidates were fetched from
+	seedKey    string             // Seeds the candidates were fetched with
+
+	wakeCh chan struct{}
+}
+
+func newBGMPool() *bgmPool {
+	return &bgmPool{wakeCh: make(chan struct{}, 1)}
+}
+
+// wake asks the prefetcher to refresh the pool without blocking.
+func (p *bgmPool) wake() {
+	select {
+	case p.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// take removes and returns the first candidate accepted by keep.
+// Rejected candidates, and candidates of other providers than provider, are dropped from the pool.
+func (p *bgmPool) take(provider *bgm.ProviderChain, keep func(bgm.CandidateWithSource) bool) (bgm.CandidateWithSource, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider != provider {
+		p.candidates = nil
+	}
+	for len(p.candidates) > 0 {
+		c := p.candidates[0]
+		p.candidates = p.candidates[1:]
+		if keep(c) {
+			return c, true
+		}
+	}
+	return bgm.CandidateWithSource{}, false
+}
+
+// runBGMPrefetcher keeps the BGM pool filled until the session is closed.
+func (m *Manager) runBGMPrefetcher() {
+	ticker := time.NewTicker(bgmPrefetchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.bgmPool.wakeCh:
+		}
+
+		if m.stateMgr.GetPhase() != state.PhaseActive || !m.stateMgr.IsAccepting() {
+			continue
+		}
+		m.refreshBGMPool(m.ctx)
+	}
+}
+
+// refreshBGMPool fetches candidates until the pool holds prefetch_count of them.
+// The pool is emptied first if the seeds or the providers changed since it was filled.
+func (m *Manager) refreshBGMPool(ctx context.Context) {
+	m.mu.RLock()
+	bgmProvider := m.bgmProvider
+	m.mu.RUnlock()
+	if bgmProvider == nil {
+		return
+	}
+
+	seedTracks := m.getRecentTracks(bgmProvider.SeedCount(m.config.BGM.SeedTrackCount))
+	seedIDs := make([]string, len(seedTracks))
+	for i, t := range seedTracks {
+		seedIDs[i] = t.ID
+	}
+	seedKey := strings.Join(seedIDs, ",")
+
+	pool := m.bgmPool
+	size := m.config.BGM.PrefetchCount
+	excludeSet := make(map[string]bool)
+	for _, id := range m.playback.GetAllTrackIDs() {
+		excludeSet[id] = true
+	}
+
+	pool.mu.Lock()
+	if pool.provider != bgmProvider || pool.seedKey != seedKey {
+		zlog.Debug().Msgf("BGM pool outdated, refetching: dropped=%d", len(pool.candidates))
+		pool.candidates = nil
+		pool.provider = bgmProvider
+		pool.seedKey = seedKey
+	}
+	missing := size - len(pool.candidates)
+	for _, c := range pool.candidates {
+		excludeSet[c.Track.ID] = true
+	}
+	pool.mu.Unlock()
+	if missing <= 0 {
+		return
+	}
+
+	candidates, err := bgmProvider.GetCandidates(ctx, m.config.BGM.CandidateCount, seedTracks, excludeSet)
+	if err != nil {
+		zlog.Warn().Msgf("failed to prefetch BGM candidates: %v", err)
+		return
+	}
+	candidates = m.rankBGMCandidates(ctx, candidates)
+
+	var accepted []bgm.CandidateWithSource
+	for _, c := range candidates {
+		if len(accepted) == missing {
+			break
+		}
+		if result := m.checkBGMCandidate(ctx, c); !result.Accepted {
+			zlog.Debug().Msgf("prefetched BGM candidate rejected by filter: track_id=%s name=%s reason=%s", c.Track.ID, c.Track.Name, result.Code)
+			continue
+		}
+		accepted = append(accepted, c)
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	// The seeds may have changed while fetching; the next refresh replaces the candidates then
+	if pool.provider == bgmProvider && pool.seedKey == seedKey {
+		pool.candidates = append(pool.candidates, accepted...)
+	}
+	zlog.Debug().Msgf("BGM pool refreshed: added=%d size=%d", len(accepted), len(pool.candidates))
+}
+
+// takePrefetchedBGM returns a pooled candidate that still passes the filter chain
+// and is not by a recently played artist, and wakes the prefetcher to refill the pool.
+func (m *Manager) takePrefetchedBGM(ctx context.Context) (bgm.CandidateWithSource, bool) {
+	if m.config.BGM.PrefetchCount <= 0 {
+		return bgm.CandidateWithSource{}, false
+	}
+	defer m.bgmPool.wake()
+
+	m.mu.RLock()
+	bgmProvider := m.bgmProvider
+	m.mu.RUnlock()
+
+	return m.bgmPool.take(bgmProvider, func(c bgm.CandidateWithSource) bool {
+		if m.playback.IsInQueue(c.Track.ID) {
+			return false
+		}
+		m.mu.RLock()
+		recent := m.isRecentArtistLocked(c.Track.Artists)
+		m.mu.RUnlock()
+		if recent {
+			return false
+		}
+		return m.checkBGMCandidate(ctx, c).Accepted
+	})
+}
+
+// checkBGMCandidate runs a BGM candidate through the filter chain.
+func (m *Manager) checkBGMCandidate(ctx context.Context, c bgm.CandidateWithSource) filter.Result {
+	req := filter.TrackRequest{
+		ListenerID: m.systemUser.ID,
+		TrackID:    c.Track.ID,
+	}
+	return m.filterChain.Execute(ctx, req, c.Track, m.systemUser, track.RequesterTypeBGM)
+}
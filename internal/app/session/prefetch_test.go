@@ -0,0 +1,127 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/app/bgm"
+	"github.com/osa030/19box/internal/app/filter"
+	"github.com/osa030/19box/internal/app/playback"
+	"github.com/osa030/19box/internal/app/session/state"
+	"github.com/osa030/19box/internal/domain/listener"
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+// countingProvider returns numbered tracks that are not excluded, and records its calls.
+type countingProvider struct {
+	next     int
+	calls    int
+	excluded map[string]bool // Exclusions of the last call
+}
+
+func (p *countingProvider) GetCandidates(ctx context.Context, count int, seedTracks []track.Track, existingTrackIDs map[string]bool) ([]track.Track, error) {
+	p.calls++
+	p.excluded = existingTrackIDs
+	tracks := make([]track.Track, count)
+	for i := range tracks {
+		p.next++
+		tracks[i] = track.Track{ID: fmt.Sprintf("t%d", p.next)}
+	}
+	return tracks, nil
+}
+
+func (p *countingProvider) Name() string { return "counting" }
+
+func candidates(ids ...string) []bgm.CandidateWithSource {
+	cs := make([]bgm.CandidateWithSource, len(ids))
+	for i, id := range ids {
+		cs[i] = bgm.CandidateWithSource{Track: track.Track{ID: id}}
+	}
+	return cs
+}
+
+func poolIDs(p *bgmPool) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ids := make([]string, len(p.candidates))
+	for i, c := range p.candidates {
+		ids[i] = c.Track.ID
+	}
+	return ids
+}
+
+func TestBGMPool_Take(t *testing.T) {
+	provider := bgm.NewProviderChain(nil, bgm.StrategyAll, 0)
+
+	pool := newBGMPool()
+	pool.provider = provider
+	pool.candidates = candidates("a", "b", "c")
+
+	// Rejected candidates are dropped on the way
+	c, ok := pool.take(provider, func(c bgm.CandidateWithSource) bool { return c.Track.ID != "a" })
+	require.True(t, ok)
+	assert.Equal(t, "b", c.Track.ID)
+	assert.Equal(t, []string{"c"}, poolIDs(pool))
+
+	// Nothing accepted empties the pool
+	_, ok = pool.take(provider, func(bgm.CandidateWithSource) bool { return false })
+	assert.False(t, ok)
+	assert.Empty(t, poolIDs(pool))
+
+	// Empty pool
+	_, ok = pool.take(provider, func(bgm.CandidateWithSource) bool { return true })
+	assert.False(t, ok)
+
+	// Candidates of other providers are dropped
+	pool.candidates = candidates("d")
+	_, ok = pool.take(bgm.NewProviderChain(nil, bgm.StrategyAll, 0), func(bgm.CandidateWithSource) bool { return true })
+	assert.False(t, ok)
+	assert.Empty(t, poolIDs(pool))
+}
+
+func TestManager_RefreshBGMPool(t *testing.T) {
+	provider := &countingProvider{}
+	c := playback.NewController(playback.Config{})
+	t.Cleanup(c.Close)
+	m := &Manager{
+		config: &config.Config{BGM: config.BGMConfig{
+			CandidateCount: 4,
+			PrefetchCount:  3,
+			SeedTrackCount: 1,
+		}},
+		stateMgr:    state.New("session"),
+		playback:    c,
+		filterChain: filter.NewChain(),
+		systemUser:  listener.NewSession("system", "System", "", false),
+		bgmProvider: bgm.NewProviderChain([]bgm.ProviderWithMetadata{{Provider: provider, DisplayName: "BGM"}}, bgm.StrategyAll, 0),
+		bgmPool:     newBGMPool(),
+	}
+	ctx := context.Background()
+
+	// An empty pool is filled up to prefetch_count
+	m.refreshBGMPool(ctx)
+	assert.Equal(t, 1, provider.calls)
+	assert.Equal(t, []string{"t1", "t2", "t3"}, poolIDs(m.bgmPool))
+
+	// A full pool is left alone
+	m.refreshBGMPool(ctx)
+	assert.Equal(t, 1, provider.calls)
+
+	// Taking candidates lets the pool run low, and the next refresh refills it
+	for _, want := range []string{"t1", "t2"} {
+		got, ok := m.takePrefetchedBGM(ctx)
+		require.True(t, ok)
+		assert.Equal(t, want, got.Track.ID)
+	}
+	assert.Equal(t, []string{"t3"}, poolIDs(m.bgmPool))
+
+	m.refreshBGMPool(ctx)
+	assert.Equal(t, 2, provider.calls)
+	assert.True(t, provider.excluded["t3"], "pooled candidates are not fetched again")
+	assert.Equal(t, []string{"t3", "t5", "t6"}, poolIDs(m.bgmPool))
+}
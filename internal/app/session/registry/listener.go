@@ -2,6 +2,7 @@ package registry
 
 import (
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
@@ -102,6 +103,47 @@ func (r *ListenerRegistry) Kick(listenerID string) error {
 	return nil
 }
 
+// Subscribe records that a listener opened a notification stream.
+func (r *ListenerRegistry) Subscribe(listenerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, ok := r.listeners[listenerID]
+	if !ok {
+		return ErrInvalidListener
+	}
+	if session.IsKicked {
+		return ErrListenerKicked
+	}
+	session.Subscribe()
+	return nil
+}
+
+// Unsubscribe records that a listener closed a notification stream.
+func (r *ListenerRegistry) Unsubscribe(listenerID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if session, ok := r.listeners[listenerID]; ok {
+		session.Unsubscribe()
+	}
+}
+
+// Present returns the IDs of the listeners who are in the session: subscribed
+// to notifications or active within activeWithin, and not kicked.
+func (r *ListenerRegistry) Present(activeWithin time.Duration, now time.Time) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var ids []string
+	for id, session := range r.listeners {
+		if session.IsPresent(activeWithin, now) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 // IncrementPending increments a listener's pending track count.
 func (r *ListenerRegistry) IncrementPending(listenerID string) error {
 	r.mu.Lock()
@@ -0,0 +1,176 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/app/bgm"
+	"github.com/osa030/19box/internal/app/playback"
+	"github.com/osa030/19box/internal/app/session/state"
+	"github.com/osa030/19box/internal/domain/track"
+	jukeboxv1 "github.com/osa030/19box/internal/gen/jukebox/v1"
+	"github.com/osa030/19box/internal/infra/config"
+)
+
+// scheduledSegment is a configured segment with its start time resolved for this session.
+type scheduledSegment struct {
+	cfg       config.SegmentConfig
+	startTime time.Time
+	bgm       *bgm.ProviderChain // nil uses the session's providers
+}
+
+// newScheduledSegments creates the BGM provider chains of the configured segments.
+// Start times are resolved when the session starts.
+func newScheduledSegments(cfg *config.Config, bgmClients *bgm.SharedClients) ([]scheduledSegment, error) {
+	segments := make([]scheduledSegment, len(cfg.Session.Segments))
+	for i, seg := range cfg.Session.Segments {
+		segments[i].cfg = seg
+		if len(seg.BGMProviders) == 0 {
+			continue
+		}
+		chain, err := bgm.NewProviderChainFromProviders(seg.BGMProviders, cfg.BGM, bgmClients)
+		if err != nil {
+			return nil, err
+		}
+		segments[i].bgm = chain
+	}
+	return segments, nil
+}
+
+// resolveSegmentsLocked resolves the segment start times for a session that
+// started at startTime. Segments that would start before the session are dropped.
+// Must be called with m.mu held.
+func (m *Manager) resolveSegmentsLocked(startTime time.Time) {
+	resolved := make([]scheduledSegment, 0, len(m.segments))
+	for _, seg := range m.segments {
+		at, err := seg.cfg.ParseAt(startTime)
+		if err != nil {
+			zlog.Error().Msgf("failed to resolve segment start: %v", err)
+			continue
+		}
+		if at.Before(startTime) {
+			zlog.Warn().Msgf("segment starts before the session, skipping: segment=%s at=%v", seg.cfg.Name, at)
+			continue
+		}
+		seg.startTime = at
+		resolved = append(resolved, seg)
+		zlog.Info().Msgf("segment scheduled: segment=%s at=%v", seg.cfg.Name, at)
+	}
+	m.segments = resolved
+}
+
+// startDueSegmentLocked starts the latest segment whose start time has been reached.
+// Segments that were passed over (e.g. while the server was busy) are skipped.
+// The segment playlist is left to the caller to queue with queueSegmentPlaylist
+// once m.mu is released.
+// Returns the started segment, or false if no segment started.
+// Must be called with m.mu held.
+func (m *Manager) startDueSegmentLocked(now time.Time) (config.SegmentConfig, bool) {
+	due := -1
+	for i := m.nextSegment; i < len(m.segments); i++ {
+		if now.Before(m.segments[i].startTime) {
+			break
+		}
+		due = i
+	}
+	if due < 0 {
+		return config.SegmentConfig{}, false
+	}
+	for i := m.nextSegment; i < due; i++ {
+		zlog.Warn().Msgf("segment skipped: segment=%s", m.segments[i].cfg.Name)
+	}
+	m.nextSegment = due + 1
+
+	seg := m.segments[due]
+	m.stateMgr.SetSegment(state.Segment{
+		Name:           seg.cfg.Name,
+		StartTime:      seg.startTime,
+		RequestsPaused: seg.cfg.PauseRequests,
+	})
+	zlog.Info().Msgf("segment started: segment=%s requests_paused=%v", seg.cfg.Name, seg.cfg.PauseRequests)
+
+	if seg.bgm != nil {
+		m.bgmProvider = seg.bgm
+	} else {
+		m.bgmProvider = m.defaultBGMProvider
+	}
+	return seg.cfg, true
+}
+
+// hasPendingSegmentsLocked returns true if some segments have not started yet.
+// Must be called with m.mu held.
+func (m *Manager) hasPendingSegmentsLocked() bool {
+	return m.nextSegment < len(m.segments)
+}
+
+// queueSegmentPlaylist queues the playlist of a segment and adds it to the
+// session playlist at the same position. The playlists are loaded and updated
+// without m.mu held; the queue is only changed under it.
+func (m *Manager) queueSegmentPlaylist(seg config.SegmentConfig) {
+	ctx := context.Background()
+	tracks, err := m.spotify.GetPlaylistTracks(ctx, seg.Playlist.PlaylistURL)
+	if err != nil {
+		zlog.Error().Msgf("failed to load segment playlist: segment=%s error=%v", seg.Name, err)
+		return
+	}
+	if len(tracks) == 0 {
+		zlog.Warn().Msgf("segment playlist is empty: segment=%s", seg.Name)
+		return
+	}
+
+	m.mu.Lock()
+	// The session may have moved on to the ending while the playlist was loading
+	if m.stateMgr.GetPhase() != state.PhaseActive {
+		m.mu.Unlock()
+		zlog.Warn().Msgf("session is no longer active, segment playlist not queued: segment=%s", seg.Name)
+		return
+	}
+	playlistID := m.stateMgr.GetPlaylistID()
+	queued := m.playback.GetQueuedTracks()
+	var added []track.QueuedTrack
+	var nextTrackID string
+	if seg.Mode != "preempt" || len(queued) == 0 {
+		added = m.enqueuePlaylistTracks(tracks, seg.Playlist.DisplayName, track.RequesterTypeSegment)
+	} else {
+		// Play the segment right after the current track, ahead of the queue
+		nextTrackID = queued[0].Track.ID
+		added = m.newSystemTracks(tracks, seg.Playlist.DisplayName, track.RequesterTypeSegment)
+		for i, qt := range added {
+			m.playback.Insert(qt, i)
+			m.addRecentArtistsLocked(qt.Track.Artists)
+		}
+	}
+	m.mu.Unlock()
+	zlog.Info().Msgf("queued segment playlist: segment=%s mode=%s track_count=%d", seg.Name, seg.Mode, len(tracks))
+
+	trackIDs := trackIDsOf(added)
+	if err := m.spotify.AddTracksToPlaylist(ctx, playlistID, trackIDs); err != nil {
+		zlog.Error().Msgf("failed to add segment tracks to playlist: %v", err)
+	} else if nextTrackID != "" {
+		for _, id := range trackIDs {
+			m.movePlaylistTrackBefore(ctx, playlistID, id, nextTrackID)
+		}
+	}
+
+	if m.playback.GetState() == playback.StateIdle {
+		go func() {
+			if err := m.playback.Play(); err != nil {
+				zlog.Debug().Msgf("play after segment start: %v", err)
+			}
+		}()
+	}
+}
+
+// broadcastSegmentStarted announces the current segment to listeners.
+func (m *Manager) broadcastSegmentStarted() {
+	sessionInfo := m.buildSessionInfoWithStateUnlocked()
+	zlog.Info().Msgf("broadcast SEGMENT_STARTED: segment=%s", sessionInfo.GetSegment().GetName())
+	if err := m.notification.Broadcast(&jukeboxv1.Notification{
+		Type:        jukeboxv1.NotificationType_NOTIFICATION_TYPE_SEGMENT_STARTED,
+		SessionInfo: sessionInfo,
+	}); err != nil {
+		zlog.Error().Msgf("failed to broadcast SEGMENT_STARTED: %v", err)
+	}
+}
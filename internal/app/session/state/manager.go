@@ -20,6 +20,8 @@ type Manager struct {
 	// Session lifecycle
 	phase     Phase
 	accepting AcceptingState
+	segment   *Segment // nil until the first segment starts
+	lastCall  bool     // The acceptance deadline is near
 
 	// Schedule
 	startTime      *time.Time
@@ -96,6 +98,45 @@ func (m *Manager) CanAcceptRequests() bool {
 	return m.phase == PhaseActive && m.accepting == Accepting
 }
 
+// GetSegment returns the current segment, or nil if no segment has started.
+func (m *Manager) GetSegment() *Segment {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.segment == nil {
+		return nil
+	}
+	seg := *m.segment
+	return &seg
+}
+
+// SetSegment sets the current segment.
+func (m *Manager) SetSegment(seg Segment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.segment = &seg
+}
+
+// RequestsPaused returns true if the current segment pauses user requests.
+func (m *Manager) RequestsPaused() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.segment != nil && m.segment.RequestsPaused
+}
+
+// InLastCall returns true during the last call before the acceptance deadline.
+func (m *Manager) InLastCall() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lastCall
+}
+
+// SetLastCall sets whether the last call is running.
+func (m *Manager) SetLastCall(lastCall bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastCall = lastCall
+}
+
 // GetSessionID returns the session ID.
 func (m *Manager) GetSessionID() string {
 	m.mu.RLock()
@@ -182,13 +223,33 @@ func (m *Manager) buildSessionInfoLocked() *jukeboxv1.SessionInfo {
 		endTimeStr = m.endTime.Format(time.RFC3339)
 	}
 
+	var segment *jukeboxv1.SegmentInfo
+	if m.segment != nil {
+		segment = &jukeboxv1.SegmentInfo{
+			Name:           m.segment.Name,
+			StartTime:      m.segment.StartTime.Format(time.RFC3339),
+			RequestsPaused: m.segment.RequestsPaused,
+		}
+	}
+
+	// Count down to the acceptance deadline during the last call
+	lastCall := m.lastCall && m.phase == PhaseActive && m.endTime != nil
+	var lastCallRemaining int32
+	if lastCall {
+		deadline := m.endTime.Add(-m.endingDuration)
+		lastCallRemaining = int32(max(time.Until(deadline), 0).Seconds())
+	}
+
 	return &jukeboxv1.SessionInfo{
-		SessionId:          m.sessionID,
-		PlaylistName:       m.playlistName,
-		PlaylistUrl:        m.playlistURL,
-		Keywords:           m.keywords,
-		ScheduledStartTime: startTimeStr,
-		ScheduledEndTime:   endTimeStr,
-		AcceptingRequests:  m.accepting == Accepting,
+		SessionId:                m.sessionID,
+		PlaylistName:             m.playlistName,
+		PlaylistUrl:              m.playlistURL,
+		Keywords:                 m.keywords,
+		ScheduledStartTime:       startTimeStr,
+		ScheduledEndTime:         endTimeStr,
+		AcceptingRequests:        m.accepting == Accepting && !(m.segment != nil && m.segment.RequestsPaused),
+		Segment:                  segment,
+		LastCall:                 lastCall,
+		LastCallRemainingSeconds: lastCallRemaining,
 	}
 }
@@ -1,6 +1,8 @@
 // Package state provides session state management.
 package state
 
+import "time"
+
 // Phase represents the session lifecycle phase.
 type Phase int
 
@@ -46,3 +48,11 @@ func (a AcceptingState) String() string {
 		return "unknown"
 	}
 }
+
+// Segment is a timed segment of an active session, such as a themed set.
+// A segment lasts until the next segment starts or the session ends.
+type Segment struct {
+	Name           string
+	StartTime      time.Time
+	RequestsPaused bool // User requests are not accepted during the segment
+}
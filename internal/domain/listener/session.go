@@ -15,6 +15,7 @@ type Session struct {
 	RequestQuota   int        // Request quota (N tracks per hour, future extension)
 	TotalRequests  int        // Total request count
 	LastRequestAt  *time.Time // Last request time
+	Subscriptions  int        // Number of open notification streams
 }
 
 // NewSession creates a new listener session.
@@ -49,6 +50,30 @@ func (s *Session) DecrementPendingTracks() {
 	}
 }
 
+// Subscribe records an open notification stream.
+func (s *Session) Subscribe() {
+	s.Subscriptions++
+}
+
+// Unsubscribe records a closed notification stream.
+func (s *Session) Unsubscribe() {
+	if s.Subscriptions > 0 {
+		s.Subscriptions--
+	}
+}
+
+// IsPresent checks if the listener is in the session: not kicked, and either
+// subscribed to notifications or requested a track within activeWithin.
+func (s *Session) IsPresent(activeWithin time.Duration, now time.Time) bool {
+	if s.IsKicked {
+		return false
+	}
+	if s.Subscriptions > 0 {
+		return true
+	}
+	return s.LastRequestAt != nil && now.Sub(*s.LastRequestAt) <= activeWithin
+}
+
 // Kick marks the listener as kicked.
 func (s *Session) Kick() {
 	s.IsKicked = true
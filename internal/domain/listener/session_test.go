@@ -192,3 +192,50 @@ func TestSession_PendingTracksWorkflow(t *testing.T) {
 	vipSession.IncrementPendingTracks()
 	assert.True(t, vipSession.CanRequest(), "VIP can always request regardless of pending tracks")
 }
+
+func TestSession_IsPresent(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-5 * time.Minute)
+	old := now.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		subscriptions int
+		lastRequestAt *time.Time
+		isKicked      bool
+		expected      bool
+	}{
+		{name: "joined only", expected: false},
+		{name: "subscribed", subscriptions: 1, expected: true},
+		{name: "requested recently", lastRequestAt: &recent, expected: true},
+		{name: "requested long ago", lastRequestAt: &old, expected: false},
+		{name: "kicked while subscribed", subscriptions: 1, isKicked: true, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := NewSession("test-id", "Test User", "", false)
+			session.Subscriptions = tt.subscriptions
+			session.LastRequestAt = tt.lastRequestAt
+			session.IsKicked = tt.isKicked
+
+			assert.Equal(t, tt.expected, session.IsPresent(30*time.Minute, now))
+		})
+	}
+}
+
+func TestSession_Subscriptions(t *testing.T) {
+	session := NewSession("test-id", "Test User", "", false)
+
+	session.Subscribe()
+	session.Subscribe()
+	assert.Equal(t, 2, session.Subscriptions)
+
+	session.Unsubscribe()
+	session.Unsubscribe()
+	assert.Equal(t, 0, session.Subscriptions)
+
+	// Should not go below 0
+	session.Unsubscribe()
+	assert.Equal(t, 0, session.Subscriptions)
+}
@@ -6,18 +6,27 @@ import "time"
 // Track represents a Spotify track entity.
 // Contains only information retrieved from Spotify API.
 type Track struct {
-	ID          string        // Spotify Track ID
-	Name        string        // Track name
-	Artists     []string      // Artist names
-	Album       string        // Album name
-	AlbumArtURL string        // Album art URL
-	Duration    time.Duration // Track duration
-	URL         string        // Spotify URL
-	Genres      []string      // Genres (from artist info)
-	Popularity  int           // Popularity score (0-100)
-	Explicit    bool          // Explicit content flag
-	Markets     []string      // Available markets
-	IsPlayable  *bool         // Playable in the specified market (nil if market not specified)
+	ID          string           // Spotify Track ID
+	Name        string           // Track name
+	Artists     []string         // Artist names
+	ArtistIDs   []string         // Spotify artist IDs, in the same order as Artists
+	Album       string           // Album name
+	AlbumArtURL string           // Album art URL
+	Duration    time.Duration    // Track duration
+	URL         string           // Spotify URL
+	Genres      []string         // Genres (from artist info)
+	Popularity  int              // Popularity score (0-100)
+	Explicit    bool             // Explicit content flag
+	Markets     []string         // Available markets
+	IsPlayable  *bool            // Playable in the specified market (nil if market not specified)
+	Attributes  *AudioAttributes // How the track sounds (nil if unknown)
+}
+
+// AudioAttributes describes how a track sounds.
+type AudioAttributes struct {
+	Energy  float64  // Intensity and activity (0.0-1.0)
+	Tempo   *float64 // Beats per minute (nil if unknown)
+	Valence *float64 // Musical positiveness (0.0: sad, 1.0: happy; nil if unknown)
 }
 
 // RequesterType represents the type of requester.
@@ -29,6 +38,9 @@ const (
 	RequesterTypeOpening RequesterType = "OPENING"
 	RequesterTypeEnding  RequesterType = "ENDING"
 	RequesterTypeBGM     RequesterType = "BGM"
+	RequesterTypeAdmin   RequesterType = "ADMIN"
+	RequesterTypeJingle  RequesterType = "JINGLE"
+	RequesterTypeSegment RequesterType = "SEGMENT"
 )
 
 // Requester represents the person who requested the track.
@@ -44,6 +56,7 @@ type QueuedTrack struct {
 	Track     Track     // Spotify track info
 	Requester Requester // Requester info
 	AddedAt   time.Time // Time when added to queue
+	Replay    bool      // Played again (e.g. after going back to the previous track)
 }
 
 // IsAvailableInMarket checks if the track is available in the specified market.
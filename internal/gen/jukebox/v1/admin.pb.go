@@ -22,7 +22,9 @@ const (
 )
 
 type GetStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -57,6 +59,13 @@ func (*GetStatusRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type GetStatusResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 現在再生中のトラック情報
@@ -65,8 +74,10 @@ type GetStatusResponse struct {
 	QueueSize int32 `protobuf:"varint,2,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
 	// リスナー数
 	ListenerCount int32 `protobuf:"varint,3,opt,name=listener_count,json=listenerCount,proto3" json:"listener_count,omitempty"`
-	// セッション情報
-	SessionInfo   *SessionInfo `protobuf:"bytes,4,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	// セッション情報（セッションが存在しない場合は未設定）
+	SessionInfo *SessionInfo `protobuf:"bytes,4,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	// 実際の再生とのズレ（ドリフト検出が無効、または未計測の場合は未設定）
+	Drift         *PlaybackDrift `protobuf:"bytes,5,opt,name=drift,proto3" json:"drift,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,8 +140,17 @@ func (x *GetStatusResponse) GetSessionInfo() *SessionInfo {
 	return nil
 }
 
+func (x *GetStatusResponse) GetDrift() *PlaybackDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
 type PauseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -165,6 +185,13 @@ func (*PauseRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *PauseRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type PauseResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 成功フラグ
@@ -220,7 +247,9 @@ func (x *PauseResponse) GetMessage() string {
 }
 
 type ResumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -255,6 +284,13 @@ func (*ResumeRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *ResumeRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type ResumeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 成功フラグ
@@ -310,7 +346,9 @@ func (x *ResumeResponse) GetMessage() string {
 }
 
 type SkipRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -345,6 +383,13 @@ func (*SkipRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *SkipRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type SkipResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 成功フラグ
@@ -399,28 +444,30 @@ func (x *SkipResponse) GetMessage() string {
 	return ""
 }
 
-type KickRequest struct {
+type SeekRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// キック対象のリスナーID
-	ListenerId    string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// 再生位置（ミリ秒）
+	PositionMs    int64 `protobuf:"varint,2,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KickRequest) Reset() {
-	*x = KickRequest{}
+func (x *SeekRequest) Reset() {
+	*x = SeekRequest{}
 	mi := &file_jukebox_v1_admin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KickRequest) String() string {
+func (x *SeekRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickRequest) ProtoMessage() {}
+func (*SeekRequest) ProtoMessage() {}
 
-func (x *KickRequest) ProtoReflect() protoreflect.Message {
+func (x *SeekRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jukebox_v1_admin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,42 +479,51 @@ func (x *KickRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickRequest.ProtoReflect.Descriptor instead.
-func (*KickRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeekRequest.ProtoReflect.Descriptor instead.
+func (*SeekRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *KickRequest) GetListenerId() string {
+func (x *SeekRequest) GetSessionId() string {
 	if x != nil {
-		return x.ListenerId
+		return x.SessionId
 	}
 	return ""
 }
 
-type KickResponse struct {
+func (x *SeekRequest) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+type SeekResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 成功フラグ
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// メッセージ
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 操作後の現在のトラック情報
+	TrackInfo     *TrackInfo `protobuf:"bytes,3,opt,name=track_info,json=trackInfo,proto3" json:"track_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KickResponse) Reset() {
-	*x = KickResponse{}
+func (x *SeekResponse) Reset() {
+	*x = SeekResponse{}
 	mi := &file_jukebox_v1_admin_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KickResponse) String() string {
+func (x *SeekResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickResponse) ProtoMessage() {}
+func (*SeekResponse) ProtoMessage() {}
 
-func (x *KickResponse) ProtoReflect() protoreflect.Message {
+func (x *SeekResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jukebox_v1_admin_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -479,45 +535,54 @@ func (x *KickResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickResponse.ProtoReflect.Descriptor instead.
-func (*KickResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeekResponse.ProtoReflect.Descriptor instead.
+func (*SeekResponse) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *KickResponse) GetSuccess() bool {
+func (x *SeekResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *KickResponse) GetMessage() string {
+func (x *SeekResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ListListenersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SeekResponse) GetTrackInfo() *TrackInfo {
+	if x != nil {
+		return x.TrackInfo
+	}
+	return nil
+}
+
+type RestartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListListenersRequest) Reset() {
-	*x = ListListenersRequest{}
+func (x *RestartRequest) Reset() {
+	*x = RestartRequest{}
 	mi := &file_jukebox_v1_admin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListListenersRequest) String() string {
+func (x *RestartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListListenersRequest) ProtoMessage() {}
+func (*RestartRequest) ProtoMessage() {}
 
-func (x *ListListenersRequest) ProtoReflect() protoreflect.Message {
+func (x *RestartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jukebox_v1_admin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,33 +594,44 @@ func (x *ListListenersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListListenersRequest.ProtoReflect.Descriptor instead.
-func (*ListListenersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartRequest.ProtoReflect.Descriptor instead.
+func (*RestartRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{10}
 }
 
-type ListListenersResponse struct {
+func (x *RestartRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RestartResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// リスナーリスト
-	Listeners     []*ListenerInfo `protobuf:"bytes,1,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 操作後の現在のトラック情報
+	TrackInfo     *TrackInfo `protobuf:"bytes,3,opt,name=track_info,json=trackInfo,proto3" json:"track_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListListenersResponse) Reset() {
-	*x = ListListenersResponse{}
+func (x *RestartResponse) Reset() {
+	*x = RestartResponse{}
 	mi := &file_jukebox_v1_admin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListListenersResponse) String() string {
+func (x *RestartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListListenersResponse) ProtoMessage() {}
+func (*RestartResponse) ProtoMessage() {}
 
-func (x *ListListenersResponse) ProtoReflect() protoreflect.Message {
+func (x *RestartResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jukebox_v1_admin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,48 +643,54 @@ func (x *ListListenersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListListenersResponse.ProtoReflect.Descriptor instead.
-func (*ListListenersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartResponse.ProtoReflect.Descriptor instead.
+func (*RestartResponse) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListListenersResponse) GetListeners() []*ListenerInfo {
+func (x *RestartResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Listeners
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestartResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RestartResponse) GetTrackInfo() *TrackInfo {
+	if x != nil {
+		return x.TrackInfo
 	}
 	return nil
 }
 
-type ListenerInfo struct {
+type PreviousRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// リスナーID（UUID）
-	ListenerId string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
-	// 表示名
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// 再生待ち楽曲数
-	PendingTracks int32 `protobuf:"varint,3,opt,name=pending_tracks,json=pendingTracks,proto3" json:"pending_tracks,omitempty"`
-	// 参加時刻（RFC3339形式）
-	JoinedAt string `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	// キック状態
-	IsKicked      bool `protobuf:"varint,5,opt,name=is_kicked,json=isKicked,proto3" json:"is_kicked,omitempty"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenerInfo) Reset() {
-	*x = ListenerInfo{}
+func (x *PreviousRequest) Reset() {
+	*x = PreviousRequest{}
 	mi := &file_jukebox_v1_admin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenerInfo) String() string {
+func (x *PreviousRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenerInfo) ProtoMessage() {}
+func (*PreviousRequest) ProtoMessage() {}
 
-func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
+func (x *PreviousRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jukebox_v1_admin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -620,67 +702,106 @@ func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenerInfo.ProtoReflect.Descriptor instead.
-func (*ListenerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviousRequest.ProtoReflect.Descriptor instead.
+func (*PreviousRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListenerInfo) GetListenerId() string {
+func (x *PreviousRequest) GetSessionId() string {
 	if x != nil {
-		return x.ListenerId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ListenerInfo) GetDisplayName() string {
+type PreviousResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 操作後の現在のトラック情報
+	TrackInfo     *TrackInfo `protobuf:"bytes,3,opt,name=track_info,json=trackInfo,proto3" json:"track_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviousResponse) Reset() {
+	*x = PreviousResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviousResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousResponse) ProtoMessage() {}
+
+func (x *PreviousResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[13]
 	if x != nil {
-		return x.DisplayName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListenerInfo) GetPendingTracks() int32 {
+// Deprecated: Use PreviousResponse.ProtoReflect.Descriptor instead.
+func (*PreviousResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreviousResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PendingTracks
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ListenerInfo) GetJoinedAt() string {
+func (x *PreviousResponse) GetMessage() string {
 	if x != nil {
-		return x.JoinedAt
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListenerInfo) GetIsKicked() bool {
+func (x *PreviousResponse) GetTrackInfo() *TrackInfo {
 	if x != nil {
-		return x.IsKicked
+		return x.TrackInfo
 	}
-	return false
+	return nil
 }
 
-type StopSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type KickRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// キック対象のリスナーID
+	ListenerId string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopSessionRequest) Reset() {
-	*x = StopSessionRequest{}
-	mi := &file_jukebox_v1_admin_proto_msgTypes[13]
+func (x *KickRequest) Reset() {
+	*x = KickRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopSessionRequest) String() string {
+func (x *KickRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopSessionRequest) ProtoMessage() {}
+func (*KickRequest) ProtoMessage() {}
 
-func (x *StopSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jukebox_v1_admin_proto_msgTypes[13]
+func (x *KickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,12 +812,26 @@ func (x *StopSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopSessionRequest.ProtoReflect.Descriptor instead.
-func (*StopSessionRequest) Descriptor() ([]byte, []int) {
-	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use KickRequest.ProtoReflect.Descriptor instead.
+func (*KickRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{14}
 }
 
-type StopSessionResponse struct {
+func (x *KickRequest) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *KickRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type KickResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 成功フラグ
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -706,21 +841,21 @@ type StopSessionResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopSessionResponse) Reset() {
-	*x = StopSessionResponse{}
-	mi := &file_jukebox_v1_admin_proto_msgTypes[14]
+func (x *KickResponse) Reset() {
+	*x = KickResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopSessionResponse) String() string {
+func (x *KickResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopSessionResponse) ProtoMessage() {}
+func (*KickResponse) ProtoMessage() {}
 
-func (x *StopSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jukebox_v1_admin_proto_msgTypes[14]
+func (x *KickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,57 +866,1699 @@ func (x *StopSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopSessionResponse.ProtoReflect.Descriptor instead.
-func (*StopSessionResponse) Descriptor() ([]byte, []int) {
-	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use KickResponse.ProtoReflect.Descriptor instead.
+func (*KickResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *StopSessionResponse) GetSuccess() bool {
+func (x *KickResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *StopSessionResponse) GetMessage() string {
+func (x *KickResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-var File_jukebox_v1_admin_proto protoreflect.FileDescriptor
+type ListListenersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_jukebox_v1_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x16jukebox/v1/admin.proto\x12\n" +
-	"jukebox.v1\x1a\x19jukebox/v1/listener.proto\"\x12\n" +
-	"\x10GetStatusRequest\"\xd1\x01\n" +
-	"\x11GetStatusResponse\x12:\n" +
-	"\rcurrent_track\x18\x01 \x01(\v2\x15.jukebox.v1.TrackInfoR\fcurrentTrack\x12\x1d\n" +
-	"\n" +
-	"queue_size\x18\x02 \x01(\x05R\tqueueSize\x12%\n" +
-	"\x0elistener_count\x18\x03 \x01(\x05R\rlistenerCount\x12:\n" +
-	"\fsession_info\x18\x04 \x01(\v2\x17.jukebox.v1.SessionInfoR\vsessionInfo\"\x0e\n" +
-	"\fPauseRequest\"C\n" +
-	"\rPauseResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x0f\n" +
-	"\rResumeRequest\"D\n" +
-	"\x0eResumeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\r\n" +
-	"\vSkipRequest\"B\n" +
-	"\fSkipResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
-	"\vKickRequest\x12\x1f\n" +
-	"\vlistener_id\x18\x01 \x01(\tR\n" +
-	"listenerId\"B\n" +
-	"\fKickResponse\x12\x18\n" +
+func (x *ListListenersRequest) Reset() {
+	*x = ListListenersRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListenersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListenersRequest) ProtoMessage() {}
+
+func (x *ListListenersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListenersRequest.ProtoReflect.Descriptor instead.
+func (*ListListenersRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListListenersRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ListListenersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// リスナーリスト
+	Listeners     []*ListenerInfo `protobuf:"bytes,1,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListListenersResponse) Reset() {
+	*x = ListListenersResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListenersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListenersResponse) ProtoMessage() {}
+
+func (x *ListListenersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListenersResponse.ProtoReflect.Descriptor instead.
+func (*ListListenersResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListListenersResponse) GetListeners() []*ListenerInfo {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+type ListenerInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// リスナーID（UUID）
+	ListenerId string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	// 表示名
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// 再生待ち楽曲数
+	PendingTracks int32 `protobuf:"varint,3,opt,name=pending_tracks,json=pendingTracks,proto3" json:"pending_tracks,omitempty"`
+	// 参加時刻（RFC3339形式）
+	JoinedAt string `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// キック状態
+	IsKicked      bool `protobuf:"varint,5,opt,name=is_kicked,json=isKicked,proto3" json:"is_kicked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListenerInfo) Reset() {
+	*x = ListenerInfo{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListenerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerInfo) ProtoMessage() {}
+
+func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerInfo.ProtoReflect.Descriptor instead.
+func (*ListenerInfo) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListenerInfo) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *ListenerInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ListenerInfo) GetPendingTracks() int32 {
+	if x != nil {
+		return x.PendingTracks
+	}
+	return 0
+}
+
+func (x *ListenerInfo) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *ListenerInfo) GetIsKicked() bool {
+	if x != nil {
+		return x.IsKicked
+	}
+	return false
+}
+
+type StopSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopSessionRequest) Reset() {
+	*x = StopSessionRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSessionRequest) ProtoMessage() {}
+
+func (x *StopSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSessionRequest.ProtoReflect.Descriptor instead.
+func (*StopSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StopSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type StopSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopSessionResponse) Reset() {
+	*x = StopSessionResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSessionResponse) ProtoMessage() {}
+
+func (x *StopSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSessionResponse.ProtoReflect.Descriptor instead.
+func (*StopSessionResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StopSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetRequestStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 拒否回数上位トラックの取得件数（0の場合は全件）
+	TopTracks int32 `protobuf:"varint,1,opt,name=top_tracks,json=topTracks,proto3" json:"top_tracks,omitempty"`
+	// 直近のリクエスト履歴の取得件数（0の場合は取得しない）
+	RecentLimit int32 `protobuf:"varint,2,opt,name=recent_limit,json=recentLimit,proto3" json:"recent_limit,omitempty"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequestStatsRequest) Reset() {
+	*x = GetRequestStatsRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequestStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestStatsRequest) ProtoMessage() {}
+
+func (x *GetRequestStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRequestStatsRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRequestStatsRequest) GetTopTracks() int32 {
+	if x != nil {
+		return x.TopTracks
+	}
+	return 0
+}
+
+func (x *GetRequestStatsRequest) GetRecentLimit() int32 {
+	if x != nil {
+		return x.RecentLimit
+	}
+	return 0
+}
+
+func (x *GetRequestStatsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetRequestStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 総リクエスト数
+	TotalRequests int32 `protobuf:"varint,1,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+	// 受付済みリクエスト数
+	AcceptedRequests int32 `protobuf:"varint,2,opt,name=accepted_requests,json=acceptedRequests,proto3" json:"accepted_requests,omitempty"`
+	// 拒否コード別の件数（件数の多い順）
+	RejectionCounts []*RejectionCount `protobuf:"bytes,3,rep,name=rejection_counts,json=rejectionCounts,proto3" json:"rejection_counts,omitempty"`
+	// リスナー別の統計（リクエスト数の多い順）
+	Listeners []*ListenerRequestStats `protobuf:"bytes,4,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	// 拒否回数の多いトラック
+	TopRejectedTracks []*TrackRejectionStats `protobuf:"bytes,5,rep,name=top_rejected_tracks,json=topRejectedTracks,proto3" json:"top_rejected_tracks,omitempty"`
+	// フィルター別の評価統計
+	Filters []*FilterEvaluationStats `protobuf:"bytes,6,rep,name=filters,proto3" json:"filters,omitempty"`
+	// 直近のリクエスト履歴（古い順）
+	RecentRequests []*RequestAuditEntry `protobuf:"bytes,7,rep,name=recent_requests,json=recentRequests,proto3" json:"recent_requests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRequestStatsResponse) Reset() {
+	*x = GetRequestStatsResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequestStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestStatsResponse) ProtoMessage() {}
+
+func (x *GetRequestStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRequestStatsResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRequestStatsResponse) GetTotalRequests() int32 {
+	if x != nil {
+		return x.TotalRequests
+	}
+	return 0
+}
+
+func (x *GetRequestStatsResponse) GetAcceptedRequests() int32 {
+	if x != nil {
+		return x.AcceptedRequests
+	}
+	return 0
+}
+
+func (x *GetRequestStatsResponse) GetRejectionCounts() []*RejectionCount {
+	if x != nil {
+		return x.RejectionCounts
+	}
+	return nil
+}
+
+func (x *GetRequestStatsResponse) GetListeners() []*ListenerRequestStats {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *GetRequestStatsResponse) GetTopRejectedTracks() []*TrackRejectionStats {
+	if x != nil {
+		return x.TopRejectedTracks
+	}
+	return nil
+}
+
+func (x *GetRequestStatsResponse) GetFilters() []*FilterEvaluationStats {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetRequestStatsResponse) GetRecentRequests() []*RequestAuditEntry {
+	if x != nil {
+		return x.RecentRequests
+	}
+	return nil
+}
+
+type RejectionCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 拒否コード
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 件数
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectionCount) Reset() {
+	*x = RejectionCount{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectionCount) ProtoMessage() {}
+
+func (x *RejectionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectionCount.ProtoReflect.Descriptor instead.
+func (*RejectionCount) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RejectionCount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RejectionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListenerRequestStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// リスナーID（UUID）
+	ListenerId string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	// 表示名
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// リクエスト数
+	Requests int32 `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	// 受付済み数
+	Accepted int32 `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// 受付率（0.0-1.0）
+	AcceptanceRate float64 `protobuf:"fixed64,5,opt,name=acceptance_rate,json=acceptanceRate,proto3" json:"acceptance_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListenerRequestStats) Reset() {
+	*x = ListenerRequestStats{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListenerRequestStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerRequestStats) ProtoMessage() {}
+
+func (x *ListenerRequestStats) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerRequestStats.ProtoReflect.Descriptor instead.
+func (*ListenerRequestStats) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListenerRequestStats) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *ListenerRequestStats) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ListenerRequestStats) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ListenerRequestStats) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *ListenerRequestStats) GetAcceptanceRate() float64 {
+	if x != nil {
+		return x.AcceptanceRate
+	}
+	return 0
+}
+
+type TrackRejectionStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Spotify Track ID
+	TrackId string `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// 曲名
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 拒否回数
+	Rejections int32 `protobuf:"varint,3,opt,name=rejections,proto3" json:"rejections,omitempty"`
+	// 拒否コード別の件数
+	Codes         []*RejectionCount `protobuf:"bytes,4,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackRejectionStats) Reset() {
+	*x = TrackRejectionStats{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackRejectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackRejectionStats) ProtoMessage() {}
+
+func (x *TrackRejectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackRejectionStats.ProtoReflect.Descriptor instead.
+func (*TrackRejectionStats) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TrackRejectionStats) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *TrackRejectionStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrackRejectionStats) GetRejections() int32 {
+	if x != nil {
+		return x.Rejections
+	}
+	return 0
+}
+
+func (x *TrackRejectionStats) GetCodes() []*RejectionCount {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type FilterEvaluationStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// フィルター名
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 評価回数
+	Evaluations int32 `protobuf:"varint,2,opt,name=evaluations,proto3" json:"evaluations,omitempty"`
+	// 拒否回数
+	Rejections int32 `protobuf:"varint,3,opt,name=rejections,proto3" json:"rejections,omitempty"`
+	// 平均処理時間（マイクロ秒）
+	AverageLatencyUs int64 `protobuf:"varint,4,opt,name=average_latency_us,json=averageLatencyUs,proto3" json:"average_latency_us,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FilterEvaluationStats) Reset() {
+	*x = FilterEvaluationStats{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterEvaluationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterEvaluationStats) ProtoMessage() {}
+
+func (x *FilterEvaluationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterEvaluationStats.ProtoReflect.Descriptor instead.
+func (*FilterEvaluationStats) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FilterEvaluationStats) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *FilterEvaluationStats) GetEvaluations() int32 {
+	if x != nil {
+		return x.Evaluations
+	}
+	return 0
+}
+
+func (x *FilterEvaluationStats) GetRejections() int32 {
+	if x != nil {
+		return x.Rejections
+	}
+	return 0
+}
+
+func (x *FilterEvaluationStats) GetAverageLatencyUs() int64 {
+	if x != nil {
+		return x.AverageLatencyUs
+	}
+	return 0
+}
+
+type RequestAuditEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// リスナーID（UUID）
+	ListenerId string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	// 表示名
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Spotify Track ID
+	TrackId string `protobuf:"bytes,3,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// 曲名
+	TrackName string `protobuf:"bytes,4,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// 受付結果
+	Accepted bool `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// 拒否コード
+	Code string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	// リクエスト時刻（RFC3339形式）
+	RequestedAt string `protobuf:"bytes,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// 評価されたフィルター（評価順）
+	Evaluations []*FilterEvaluation `protobuf:"bytes,8,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	// 管理者による強制追加（ForceEnqueue）か
+	Forced        bool `protobuf:"varint,9,opt,name=forced,proto3" json:"forced,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAuditEntry) Reset() {
+	*x = RequestAuditEntry{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAuditEntry) ProtoMessage() {}
+
+func (x *RequestAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAuditEntry.ProtoReflect.Descriptor instead.
+func (*RequestAuditEntry) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RequestAuditEntry) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
+func (x *RequestAuditEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RequestAuditEntry) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *RequestAuditEntry) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *RequestAuditEntry) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *RequestAuditEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RequestAuditEntry) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *RequestAuditEntry) GetEvaluations() []*FilterEvaluation {
+	if x != nil {
+		return x.Evaluations
+	}
+	return nil
+}
+
+func (x *RequestAuditEntry) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+type FilterEvaluation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// フィルター名
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 受付結果
+	Accepted bool `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// 拒否コード
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// 処理時間（マイクロ秒）
+	LatencyUs     int64 `protobuf:"varint,4,opt,name=latency_us,json=latencyUs,proto3" json:"latency_us,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterEvaluation) Reset() {
+	*x = FilterEvaluation{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterEvaluation) ProtoMessage() {}
+
+func (x *FilterEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterEvaluation.ProtoReflect.Descriptor instead.
+func (*FilterEvaluation) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FilterEvaluation) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *FilterEvaluation) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *FilterEvaluation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FilterEvaluation) GetLatencyUs() int64 {
+	if x != nil {
+		return x.LatencyUs
+	}
+	return 0
+}
+
+type ForceEnqueueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Spotify Track ID（URL/URIも可）
+	TrackId string `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// キュー内の挿入位置（1が次に再生、0またはキュー長を超える場合は末尾）
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// 選曲者として表示する名前（空の場合は管理者の表示名）
+	RequesterLabel string `protobuf:"bytes,3,opt,name=requester_label,json=requesterLabel,proto3" json:"requester_label,omitempty"`
+	// バイパスするフィルター名（空の場合は全フィルターをバイパス）
+	BypassFilters []string `protobuf:"bytes,4,rep,name=bypass_filters,json=bypassFilters,proto3" json:"bypass_filters,omitempty"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceEnqueueRequest) Reset() {
+	*x = ForceEnqueueRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceEnqueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEnqueueRequest) ProtoMessage() {}
+
+func (x *ForceEnqueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEnqueueRequest.ProtoReflect.Descriptor instead.
+func (*ForceEnqueueRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForceEnqueueRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *ForceEnqueueRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ForceEnqueueRequest) GetRequesterLabel() string {
+	if x != nil {
+		return x.RequesterLabel
+	}
+	return ""
+}
+
+func (x *ForceEnqueueRequest) GetBypassFilters() []string {
+	if x != nil {
+		return x.BypassFilters
+	}
+	return nil
+}
+
+func (x *ForceEnqueueRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ForceEnqueueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 拒否時のコード
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// 追加されたキュー内の位置（1始まり）
+	Position      int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceEnqueueResponse) Reset() {
+	*x = ForceEnqueueResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceEnqueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEnqueueResponse) ProtoMessage() {}
+
+func (x *ForceEnqueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEnqueueResponse.ProtoReflect.Descriptor instead.
+func (*ForceEnqueueResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ForceEnqueueResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ForceEnqueueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ForceEnqueueResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ForceEnqueueResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// セッションで使用するプレイリスト
+type SessionPlaylist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// プレイリストURL（空の場合は使用しない）
+	PlaylistUrl string `protobuf:"bytes,1,opt,name=playlist_url,json=playlistUrl,proto3" json:"playlist_url,omitempty"`
+	// リクエスト者として表示する名前（空の場合はサーバー設定の値）
+	DisplayName   string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionPlaylist) Reset() {
+	*x = SessionPlaylist{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionPlaylist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionPlaylist) ProtoMessage() {}
+
+func (x *SessionPlaylist) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionPlaylist.ProtoReflect.Descriptor instead.
+func (*SessionPlaylist) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SessionPlaylist) GetPlaylistUrl() string {
+	if x != nil {
+		return x.PlaylistUrl
+	}
+	return ""
+}
+
+func (x *SessionPlaylist) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type CreateSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// セッションのタイトル（空の場合はサーバー設定の値）
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// 開始時間 (RFC3339形式、空の場合は開始操作直後)
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 終了時間 (RFC3339形式、空の場合は手動停止のみ)
+	EndTime string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// キーワード（空の場合はサーバー設定の値）
+	Keywords []string `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// オープニングプレイリスト（未設定の場合はサーバー設定の値）
+	Opening *SessionPlaylist `protobuf:"bytes,5,opt,name=opening,proto3" json:"opening,omitempty"`
+	// エンディングプレイリスト（未設定の場合はサーバー設定の値）
+	Ending *SessionPlaylist `protobuf:"bytes,6,opt,name=ending,proto3" json:"ending,omitempty"`
+	// ルーム名（ルームが設定されている場合は必須、未設定の値はルームの設定から補完）
+	Room          string `protobuf:"bytes,7,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionRequest) ProtoMessage() {}
+
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateSessionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetOpening() *SessionPlaylist {
+	if x != nil {
+		return x.Opening
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetEnding() *SessionPlaylist {
+	if x != nil {
+		return x.Ending
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type CreateSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 作成されたセッションID
+	SessionId string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// セッション情報
+	SessionInfo   *SessionInfo `protobuf:"bytes,4,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionResponse) ProtoMessage() {}
+
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSessionResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CreateSessionResponse) GetSessionInfo() *SessionInfo {
+	if x != nil {
+		return x.SessionInfo
+	}
+	return nil
+}
+
+type StartSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 開始するセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSessionRequest) Reset() {
+	*x = StartSessionRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionRequest) ProtoMessage() {}
+
+func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StartSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type StartSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// セッション情報
+	SessionInfo   *SessionInfo `protobuf:"bytes,3,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartSessionResponse) Reset() {
+	*x = StartSessionResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionResponse) ProtoMessage() {}
+
+func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StartSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetSessionInfo() *SessionInfo {
+	if x != nil {
+		return x.SessionInfo
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+type ListSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// セッション情報（作成順）
+	Sessions      []*SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type UpdateScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// 新しい開始時間 (RFC3339形式、開始前のみ変更可。空の場合は変更なし)
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 新しい終了時間 (RFC3339形式、空の場合は変更なし)
+	EndTime string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 終了時間を解除する（手動停止のみにする）
+	ClearEndTime  bool `protobuf:"varint,4,opt,name=clear_end_time,json=clearEndTime,proto3" json:"clear_end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateScheduleRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetClearEndTime() bool {
+	if x != nil {
+		return x.ClearEndTime
+	}
+	return false
+}
+
+type UpdateScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功フラグ
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// メッセージ
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 変更後のセッション情報
+	SessionInfo   *SessionInfo `protobuf:"bytes,3,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleResponse) Reset() {
+	*x = UpdateScheduleResponse{}
+	mi := &file_jukebox_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateScheduleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateScheduleResponse) GetSessionInfo() *SessionInfo {
+	if x != nil {
+		return x.SessionInfo
+	}
+	return nil
+}
+
+var File_jukebox_v1_admin_proto protoreflect.FileDescriptor
+
+const file_jukebox_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x16jukebox/v1/admin.proto\x12\n" +
+	"jukebox.v1\x1a\x19jukebox/v1/listener.proto\"1\n" +
+	"\x10GetStatusRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x82\x02\n" +
+	"\x11GetStatusResponse\x12:\n" +
+	"\rcurrent_track\x18\x01 \x01(\v2\x15.jukebox.v1.TrackInfoR\fcurrentTrack\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x02 \x01(\x05R\tqueueSize\x12%\n" +
+	"\x0elistener_count\x18\x03 \x01(\x05R\rlistenerCount\x12:\n" +
+	"\fsession_info\x18\x04 \x01(\v2\x17.jukebox.v1.SessionInfoR\vsessionInfo\x12/\n" +
+	"\x05drift\x18\x05 \x01(\v2\x19.jukebox.v1.PlaybackDriftR\x05drift\"-\n" +
+	"\fPauseRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"C\n" +
+	"\rPauseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
+	"\rResumeRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"D\n" +
+	"\x0eResumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\vSkipRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"B\n" +
+	"\fSkipResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"M\n" +
+	"\vSeekRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vposition_ms\x18\x02 \x01(\x03R\n" +
+	"positionMs\"x\n" +
+	"\fSeekResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\n" +
+	"track_info\x18\x03 \x01(\v2\x15.jukebox.v1.TrackInfoR\ttrackInfo\"/\n" +
+	"\x0eRestartRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"{\n" +
+	"\x0fRestartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\n" +
+	"track_info\x18\x03 \x01(\v2\x15.jukebox.v1.TrackInfoR\ttrackInfo\"0\n" +
+	"\x0fPreviousRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"|\n" +
+	"\x10PreviousResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\n" +
+	"track_info\x18\x03 \x01(\v2\x15.jukebox.v1.TrackInfoR\ttrackInfo\"M\n" +
+	"\vKickRequest\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"B\n" +
+	"\fKickResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x16\n" +
-	"\x14ListListenersRequest\"O\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\x14ListListenersRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"O\n" +
 	"\x15ListListenersResponse\x126\n" +
 	"\tlisteners\x18\x01 \x03(\v2\x18.jukebox.v1.ListenerInfoR\tlisteners\"\xb3\x01\n" +
 	"\fListenerInfo\x12\x1f\n" +
@@ -790,19 +2567,137 @@ const file_jukebox_v1_admin_proto_rawDesc = "" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12%\n" +
 	"\x0epending_tracks\x18\x03 \x01(\x05R\rpendingTracks\x12\x1b\n" +
 	"\tjoined_at\x18\x04 \x01(\tR\bjoinedAt\x12\x1b\n" +
-	"\tis_kicked\x18\x05 \x01(\bR\bisKicked\"\x14\n" +
-	"\x12StopSessionRequest\"I\n" +
+	"\tis_kicked\x18\x05 \x01(\bR\bisKicked\"3\n" +
+	"\x12StopSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"I\n" +
 	"\x13StopSessionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xf3\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"y\n" +
+	"\x16GetRequestStatsRequest\x12\x1d\n" +
+	"\n" +
+	"top_tracks\x18\x01 \x01(\x05R\ttopTracks\x12!\n" +
+	"\frecent_limit\x18\x02 \x01(\x05R\vrecentLimit\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\"\xca\x03\n" +
+	"\x17GetRequestStatsResponse\x12%\n" +
+	"\x0etotal_requests\x18\x01 \x01(\x05R\rtotalRequests\x12+\n" +
+	"\x11accepted_requests\x18\x02 \x01(\x05R\x10acceptedRequests\x12E\n" +
+	"\x10rejection_counts\x18\x03 \x03(\v2\x1a.jukebox.v1.RejectionCountR\x0frejectionCounts\x12>\n" +
+	"\tlisteners\x18\x04 \x03(\v2 .jukebox.v1.ListenerRequestStatsR\tlisteners\x12O\n" +
+	"\x13top_rejected_tracks\x18\x05 \x03(\v2\x1f.jukebox.v1.TrackRejectionStatsR\x11topRejectedTracks\x12;\n" +
+	"\afilters\x18\x06 \x03(\v2!.jukebox.v1.FilterEvaluationStatsR\afilters\x12F\n" +
+	"\x0frecent_requests\x18\a \x03(\v2\x1d.jukebox.v1.RequestAuditEntryR\x0erecentRequests\":\n" +
+	"\x0eRejectionCount\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xbb\x01\n" +
+	"\x14ListenerRequestStats\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1a\n" +
+	"\brequests\x18\x03 \x01(\x05R\brequests\x12\x1a\n" +
+	"\baccepted\x18\x04 \x01(\x05R\baccepted\x12'\n" +
+	"\x0facceptance_rate\x18\x05 \x01(\x01R\x0eacceptanceRate\"\x96\x01\n" +
+	"\x13TrackRejectionStats\x12\x19\n" +
+	"\btrack_id\x18\x01 \x01(\tR\atrackId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"rejections\x18\x03 \x01(\x05R\n" +
+	"rejections\x120\n" +
+	"\x05codes\x18\x04 \x03(\v2\x1a.jukebox.v1.RejectionCountR\x05codes\"\x9f\x01\n" +
+	"\x15FilterEvaluationStats\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12 \n" +
+	"\vevaluations\x18\x02 \x01(\x05R\vevaluations\x12\x1e\n" +
+	"\n" +
+	"rejections\x18\x03 \x01(\x05R\n" +
+	"rejections\x12,\n" +
+	"\x12average_latency_us\x18\x04 \x01(\x03R\x10averageLatencyUs\"\xbc\x02\n" +
+	"\x11RequestAuditEntry\x12\x1f\n" +
+	"\vlistener_id\x18\x01 \x01(\tR\n" +
+	"listenerId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x19\n" +
+	"\btrack_id\x18\x03 \x01(\tR\atrackId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x04 \x01(\tR\ttrackName\x12\x1a\n" +
+	"\baccepted\x18\x05 \x01(\bR\baccepted\x12\x12\n" +
+	"\x04code\x18\x06 \x01(\tR\x04code\x12!\n" +
+	"\frequested_at\x18\a \x01(\tR\vrequestedAt\x12>\n" +
+	"\vevaluations\x18\b \x03(\v2\x1c.jukebox.v1.FilterEvaluationR\vevaluations\x12\x16\n" +
+	"\x06forced\x18\t \x01(\bR\x06forced\"y\n" +
+	"\x10FilterEvaluation\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"latency_us\x18\x04 \x01(\x03R\tlatencyUs\"\xbb\x01\n" +
+	"\x13ForceEnqueueRequest\x12\x19\n" +
+	"\btrack_id\x18\x01 \x01(\tR\atrackId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12'\n" +
+	"\x0frequester_label\x18\x03 \x01(\tR\x0erequesterLabel\x12%\n" +
+	"\x0ebypass_filters\x18\x04 \x03(\tR\rbypassFilters\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\"z\n" +
+	"\x14ForceEnqueueResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"W\n" +
+	"\x0fSessionPlaylist\x12!\n" +
+	"\fplaylist_url\x18\x01 \x01(\tR\vplaylistUrl\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\x82\x02\n" +
+	"\x14CreateSessionRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12\x1a\n" +
+	"\bkeywords\x18\x04 \x03(\tR\bkeywords\x125\n" +
+	"\aopening\x18\x05 \x01(\v2\x1b.jukebox.v1.SessionPlaylistR\aopening\x123\n" +
+	"\x06ending\x18\x06 \x01(\v2\x1b.jukebox.v1.SessionPlaylistR\x06ending\x12\x12\n" +
+	"\x04room\x18\a \x01(\tR\x04room\"\xa6\x01\n" +
+	"\x15CreateSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12:\n" +
+	"\fsession_info\x18\x04 \x01(\v2\x17.jukebox.v1.SessionInfoR\vsessionInfo\"4\n" +
+	"\x13StartSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x86\x01\n" +
+	"\x14StartSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\fsession_info\x18\x03 \x01(\v2\x17.jukebox.v1.SessionInfoR\vsessionInfo\"\x15\n" +
+	"\x13ListSessionsRequest\"K\n" +
+	"\x14ListSessionsResponse\x123\n" +
+	"\bsessions\x18\x01 \x03(\v2\x17.jukebox.v1.SessionInfoR\bsessions\"\x96\x01\n" +
+	"\x15UpdateScheduleRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12$\n" +
+	"\x0eclear_end_time\x18\x04 \x01(\bR\fclearEndTime\"\x88\x01\n" +
+	"\x16UpdateScheduleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\fsession_info\x18\x03 \x01(\v2\x17.jukebox.v1.SessionInfoR\vsessionInfo2\xbd\t\n" +
 	"\fAdminService\x12H\n" +
 	"\tGetStatus\x12\x1c.jukebox.v1.GetStatusRequest\x1a\x1d.jukebox.v1.GetStatusResponse\x12<\n" +
 	"\x05Pause\x12\x18.jukebox.v1.PauseRequest\x1a\x19.jukebox.v1.PauseResponse\x12?\n" +
 	"\x06Resume\x12\x19.jukebox.v1.ResumeRequest\x1a\x1a.jukebox.v1.ResumeResponse\x129\n" +
 	"\x04Skip\x12\x17.jukebox.v1.SkipRequest\x1a\x18.jukebox.v1.SkipResponse\x129\n" +
+	"\x04Seek\x12\x17.jukebox.v1.SeekRequest\x1a\x18.jukebox.v1.SeekResponse\x12B\n" +
+	"\aRestart\x12\x1a.jukebox.v1.RestartRequest\x1a\x1b.jukebox.v1.RestartResponse\x12E\n" +
+	"\bPrevious\x12\x1b.jukebox.v1.PreviousRequest\x1a\x1c.jukebox.v1.PreviousResponse\x129\n" +
 	"\x04Kick\x12\x17.jukebox.v1.KickRequest\x1a\x18.jukebox.v1.KickResponse\x12T\n" +
 	"\rListListeners\x12 .jukebox.v1.ListListenersRequest\x1a!.jukebox.v1.ListListenersResponse\x12N\n" +
-	"\vStopSession\x12\x1e.jukebox.v1.StopSessionRequest\x1a\x1f.jukebox.v1.StopSessionResponseB\xa0\x01\n" +
+	"\vStopSession\x12\x1e.jukebox.v1.StopSessionRequest\x1a\x1f.jukebox.v1.StopSessionResponse\x12Z\n" +
+	"\x0fGetRequestStats\x12\".jukebox.v1.GetRequestStatsRequest\x1a#.jukebox.v1.GetRequestStatsResponse\x12Q\n" +
+	"\fForceEnqueue\x12\x1f.jukebox.v1.ForceEnqueueRequest\x1a .jukebox.v1.ForceEnqueueResponse\x12T\n" +
+	"\rCreateSession\x12 .jukebox.v1.CreateSessionRequest\x1a!.jukebox.v1.CreateSessionResponse\x12Q\n" +
+	"\fStartSession\x12\x1f.jukebox.v1.StartSessionRequest\x1a .jukebox.v1.StartSessionResponse\x12Q\n" +
+	"\fListSessions\x12\x1f.jukebox.v1.ListSessionsRequest\x1a .jukebox.v1.ListSessionsResponse\x12W\n" +
+	"\x0eUpdateSchedule\x12!.jukebox.v1.UpdateScheduleRequest\x1a\".jukebox.v1.UpdateScheduleResponseB\xa0\x01\n" +
 	"\x0ecom.jukebox.v1B\n" +
 	"AdminProtoP\x01Z9github.com/osa030/19box/internal/gen/jukebox/v1;jukeboxv1\xa2\x02\x03JXX\xaa\x02\n" +
 	"Jukebox.V1\xca\x02\n" +
@@ -820,49 +2715,110 @@ func file_jukebox_v1_admin_proto_rawDescGZIP() []byte {
 	return file_jukebox_v1_admin_proto_rawDescData
 }
 
-var file_jukebox_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_jukebox_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_jukebox_v1_admin_proto_goTypes = []any{
-	(*GetStatusRequest)(nil),      // 0: jukebox.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),     // 1: jukebox.v1.GetStatusResponse
-	(*PauseRequest)(nil),          // 2: jukebox.v1.PauseRequest
-	(*PauseResponse)(nil),         // 3: jukebox.v1.PauseResponse
-	(*ResumeRequest)(nil),         // 4: jukebox.v1.ResumeRequest
-	(*ResumeResponse)(nil),        // 5: jukebox.v1.ResumeResponse
-	(*SkipRequest)(nil),           // 6: jukebox.v1.SkipRequest
-	(*SkipResponse)(nil),          // 7: jukebox.v1.SkipResponse
-	(*KickRequest)(nil),           // 8: jukebox.v1.KickRequest
-	(*KickResponse)(nil),          // 9: jukebox.v1.KickResponse
-	(*ListListenersRequest)(nil),  // 10: jukebox.v1.ListListenersRequest
-	(*ListListenersResponse)(nil), // 11: jukebox.v1.ListListenersResponse
-	(*ListenerInfo)(nil),          // 12: jukebox.v1.ListenerInfo
-	(*StopSessionRequest)(nil),    // 13: jukebox.v1.StopSessionRequest
-	(*StopSessionResponse)(nil),   // 14: jukebox.v1.StopSessionResponse
-	(*TrackInfo)(nil),             // 15: jukebox.v1.TrackInfo
-	(*SessionInfo)(nil),           // 16: jukebox.v1.SessionInfo
+	(*GetStatusRequest)(nil),        // 0: jukebox.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),       // 1: jukebox.v1.GetStatusResponse
+	(*PauseRequest)(nil),            // 2: jukebox.v1.PauseRequest
+	(*PauseResponse)(nil),           // 3: jukebox.v1.PauseResponse
+	(*ResumeRequest)(nil),           // 4: jukebox.v1.ResumeRequest
+	(*ResumeResponse)(nil),          // 5: jukebox.v1.ResumeResponse
+	(*SkipRequest)(nil),             // 6: jukebox.v1.SkipRequest
+	(*SkipResponse)(nil),            // 7: jukebox.v1.SkipResponse
+	(*SeekRequest)(nil),             // 8: jukebox.v1.SeekRequest
+	(*SeekResponse)(nil),            // 9: jukebox.v1.SeekResponse
+	(*RestartRequest)(nil),          // 10: jukebox.v1.RestartRequest
+	(*RestartResponse)(nil),         // 11: jukebox.v1.RestartResponse
+	(*PreviousRequest)(nil),         // 12: jukebox.v1.PreviousRequest
+	(*PreviousResponse)(nil),        // 13: jukebox.v1.PreviousResponse
+	(*KickRequest)(nil),             // 14: jukebox.v1.KickRequest
+	(*KickResponse)(nil),            // 15: jukebox.v1.KickResponse
+	(*ListListenersRequest)(nil),    // 16: jukebox.v1.ListListenersRequest
+	(*ListListenersResponse)(nil),   // 17: jukebox.v1.ListListenersResponse
+	(*ListenerInfo)(nil),            // 18: jukebox.v1.ListenerInfo
+	(*StopSessionRequest)(nil),      // 19: jukebox.v1.StopSessionRequest
+	(*StopSessionResponse)(nil),     // 20: jukebox.v1.StopSessionResponse
+	(*GetRequestStatsRequest)(nil),  // 21: jukebox.v1.GetRequestStatsRequest
+	(*GetRequestStatsResponse)(nil), // 22: jukebox.v1.GetRequestStatsResponse
+	(*RejectionCount)(nil),          // 23: jukebox.v1.RejectionCount
+	(*ListenerRequestStats)(nil),    // 24: jukebox.v1.ListenerRequestStats
+	(*TrackRejectionStats)(nil),     // 25: jukebox.v1.TrackRejectionStats
+	(*FilterEvaluationStats)(nil),   // 26: jukebox.v1.FilterEvaluationStats
+	(*RequestAuditEntry)(nil),       // 27: jukebox.v1.RequestAuditEntry
+	(*FilterEvaluation)(nil),        // 28: jukebox.v1.FilterEvaluation
+	(*ForceEnqueueRequest)(nil),     // 29: jukebox.v1.ForceEnqueueRequest
+	(*ForceEnqueueResponse)(nil),    // 30: jukebox.v1.ForceEnqueueResponse
+	(*SessionPlaylist)(nil),         // 31: jukebox.v1.SessionPlaylist
+	(*CreateSessionRequest)(nil),    // 32: jukebox.v1.CreateSessionRequest
+	(*CreateSessionResponse)(nil),   // 33: jukebox.v1.CreateSessionResponse
+	(*StartSessionRequest)(nil),     // 34: jukebox.v1.StartSessionRequest
+	(*StartSessionResponse)(nil),    // 35: jukebox.v1.StartSessionResponse
+	(*ListSessionsRequest)(nil),     // 36: jukebox.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),    // 37: jukebox.v1.ListSessionsResponse
+	(*UpdateScheduleRequest)(nil),   // 38: jukebox.v1.UpdateScheduleRequest
+	(*UpdateScheduleResponse)(nil),  // 39: jukebox.v1.UpdateScheduleResponse
+	(*TrackInfo)(nil),               // 40: jukebox.v1.TrackInfo
+	(*SessionInfo)(nil),             // 41: jukebox.v1.SessionInfo
+	(*PlaybackDrift)(nil),           // 42: jukebox.v1.PlaybackDrift
 }
 var file_jukebox_v1_admin_proto_depIdxs = []int32{
-	15, // 0: jukebox.v1.GetStatusResponse.current_track:type_name -> jukebox.v1.TrackInfo
-	16, // 1: jukebox.v1.GetStatusResponse.session_info:type_name -> jukebox.v1.SessionInfo
-	12, // 2: jukebox.v1.ListListenersResponse.listeners:type_name -> jukebox.v1.ListenerInfo
-	0,  // 3: jukebox.v1.AdminService.GetStatus:input_type -> jukebox.v1.GetStatusRequest
-	2,  // 4: jukebox.v1.AdminService.Pause:input_type -> jukebox.v1.PauseRequest
-	4,  // 5: jukebox.v1.AdminService.Resume:input_type -> jukebox.v1.ResumeRequest
-	6,  // 6: jukebox.v1.AdminService.Skip:input_type -> jukebox.v1.SkipRequest
-	8,  // 7: jukebox.v1.AdminService.Kick:input_type -> jukebox.v1.KickRequest
-	10, // 8: jukebox.v1.AdminService.ListListeners:input_type -> jukebox.v1.ListListenersRequest
-	13, // 9: jukebox.v1.AdminService.StopSession:input_type -> jukebox.v1.StopSessionRequest
-	1,  // 10: jukebox.v1.AdminService.GetStatus:output_type -> jukebox.v1.GetStatusResponse
-	3,  // 11: jukebox.v1.AdminService.Pause:output_type -> jukebox.v1.PauseResponse
-	5,  // 12: jukebox.v1.AdminService.Resume:output_type -> jukebox.v1.ResumeResponse
-	7,  // 13: jukebox.v1.AdminService.Skip:output_type -> jukebox.v1.SkipResponse
-	9,  // 14: jukebox.v1.AdminService.Kick:output_type -> jukebox.v1.KickResponse
-	11, // 15: jukebox.v1.AdminService.ListListeners:output_type -> jukebox.v1.ListListenersResponse
-	14, // 16: jukebox.v1.AdminService.StopSession:output_type -> jukebox.v1.StopSessionResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	40, // 0: jukebox.v1.GetStatusResponse.current_track:type_name -> jukebox.v1.TrackInfo
+	41, // 1: jukebox.v1.GetStatusResponse.session_info:type_name -> jukebox.v1.SessionInfo
+	42, // 2: jukebox.v1.GetStatusResponse.drift:type_name -> jukebox.v1.PlaybackDrift
+	40, // 3: jukebox.v1.SeekResponse.track_info:type_name -> jukebox.v1.TrackInfo
+	40, // 4: jukebox.v1.RestartResponse.track_info:type_name -> jukebox.v1.TrackInfo
+	40, // 5: jukebox.v1.PreviousResponse.track_info:type_name -> jukebox.v1.TrackInfo
+	18, // 6: jukebox.v1.ListListenersResponse.listeners:type_name -> jukebox.v1.ListenerInfo
+	23, // 7: jukebox.v1.GetRequestStatsResponse.rejection_counts:type_name -> jukebox.v1.RejectionCount
+	24, // 8: jukebox.v1.GetRequestStatsResponse.listeners:type_name -> jukebox.v1.ListenerRequestStats
+	25, // 9: jukebox.v1.GetRequestStatsResponse.top_rejected_tracks:type_name -> jukebox.v1.TrackRejectionStats
+	26, // 10: jukebox.v1.GetRequestStatsResponse.filters:type_name -> jukebox.v1.FilterEvaluationStats
+	27, // 11: jukebox.v1.GetRequestStatsResponse.recent_requests:type_name -> jukebox.v1.RequestAuditEntry
+	23, // 12: jukebox.v1.TrackRejectionStats.codes:type_name -> jukebox.v1.RejectionCount
+	28, // 13: jukebox.v1.RequestAuditEntry.evaluations:type_name -> jukebox.v1.FilterEvaluation
+	31, // 14: jukebox.v1.CreateSessionRequest.opening:type_name -> jukebox.v1.SessionPlaylist
+	31, // 15: jukebox.v1.CreateSessionRequest.ending:type_name -> jukebox.v1.SessionPlaylist
+	41, // 16: jukebox.v1.CreateSessionResponse.session_info:type_name -> jukebox.v1.SessionInfo
+	41, // 17: jukebox.v1.StartSessionResponse.session_info:type_name -> jukebox.v1.SessionInfo
+	41, // 18: jukebox.v1.ListSessionsResponse.sessions:type_name -> jukebox.v1.SessionInfo
+	41, // 19: jukebox.v1.UpdateScheduleResponse.session_info:type_name -> jukebox.v1.SessionInfo
+	0,  // 20: jukebox.v1.AdminService.GetStatus:input_type -> jukebox.v1.GetStatusRequest
+	2,  // 21: jukebox.v1.AdminService.Pause:input_type -> jukebox.v1.PauseRequest
+	4,  // 22: jukebox.v1.AdminService.Resume:input_type -> jukebox.v1.ResumeRequest
+	6,  // 23: jukebox.v1.AdminService.Skip:input_type -> jukebox.v1.SkipRequest
+	8,  // 24: jukebox.v1.AdminService.Seek:input_type -> jukebox.v1.SeekRequest
+	10, // 25: jukebox.v1.AdminService.Restart:input_type -> jukebox.v1.RestartRequest
+	12, // 26: jukebox.v1.AdminService.Previous:input_type -> jukebox.v1.PreviousRequest
+	14, // 27: jukebox.v1.AdminService.Kick:input_type -> jukebox.v1.KickRequest
+	16, // 28: jukebox.v1.AdminService.ListListeners:input_type -> jukebox.v1.ListListenersRequest
+	19, // 29: jukebox.v1.AdminService.StopSession:input_type -> jukebox.v1.StopSessionRequest
+	21, // 30: jukebox.v1.AdminService.GetRequestStats:input_type -> jukebox.v1.GetRequestStatsRequest
+	29, // 31: jukebox.v1.AdminService.ForceEnqueue:input_type -> jukebox.v1.ForceEnqueueRequest
+	32, // 32: jukebox.v1.AdminService.CreateSession:input_type -> jukebox.v1.CreateSessionRequest
+	34, // 33: jukebox.v1.AdminService.StartSession:input_type -> jukebox.v1.StartSessionRequest
+	36, // 34: jukebox.v1.AdminService.ListSessions:input_type -> jukebox.v1.ListSessionsRequest
+	38, // 35: jukebox.v1.AdminService.UpdateSchedule:input_type -> jukebox.v1.UpdateScheduleRequest
+	1,  // 36: jukebox.v1.AdminService.GetStatus:output_type -> jukebox.v1.GetStatusResponse
+	3,  // 37: jukebox.v1.AdminService.Pause:output_type -> jukebox.v1.PauseResponse
+	5,  // 38: jukebox.v1.AdminService.Resume:output_type -> jukebox.v1.ResumeResponse
+	7,  // 39: jukebox.v1.AdminService.Skip:output_type -> jukebox.v1.SkipResponse
+	9,  // 40: jukebox.v1.AdminService.Seek:output_type -> jukebox.v1.SeekResponse
+	11, // 41: jukebox.v1.AdminService.Restart:output_type -> jukebox.v1.RestartResponse
+	13, // 42: jukebox.v1.AdminService.Previous:output_type -> jukebox.v1.PreviousResponse
+	15, // 43: jukebox.v1.AdminService.Kick:output_type -> jukebox.v1.KickResponse
+	17, // 44: jukebox.v1.AdminService.ListListeners:output_type -> jukebox.v1.ListListenersResponse
+	20, // 45: jukebox.v1.AdminService.StopSession:output_type -> jukebox.v1.StopSessionResponse
+	22, // 46: jukebox.v1.AdminService.GetRequestStats:output_type -> jukebox.v1.GetRequestStatsResponse
+	30, // 47: jukebox.v1.AdminService.ForceEnqueue:output_type -> jukebox.v1.ForceEnqueueResponse
+	33, // 48: jukebox.v1.AdminService.CreateSession:output_type -> jukebox.v1.CreateSessionResponse
+	35, // 49: jukebox.v1.AdminService.StartSession:output_type -> jukebox.v1.StartSessionResponse
+	37, // 50: jukebox.v1.AdminService.ListSessions:output_type -> jukebox.v1.ListSessionsResponse
+	39, // 51: jukebox.v1.AdminService.UpdateSchedule:output_type -> jukebox.v1.UpdateScheduleResponse
+	36, // [36:52] is the sub-list for method output_type
+	20, // [20:36] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_jukebox_v1_admin_proto_init() }
@@ -877,7 +2833,7 @@ func file_jukebox_v1_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jukebox_v1_admin_proto_rawDesc), len(file_jukebox_v1_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
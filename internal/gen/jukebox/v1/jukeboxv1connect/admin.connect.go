@@ -41,6 +41,12 @@ const (
 	AdminServiceResumeProcedure = "/jukebox.v1.AdminService/Resume"
 	// AdminServiceSkipProcedure is the fully-qualified name of the AdminService's Skip RPC.
 	AdminServiceSkipProcedure = "/jukebox.v1.AdminService/Skip"
+	// AdminServiceSeekProcedure is the fully-qualified name of the AdminService's Seek RPC.
+	AdminServiceSeekProcedure = "/jukebox.v1.AdminService/Seek"
+	// AdminServiceRestartProcedure is the fully-qualified name of the AdminService's Restart RPC.
+	AdminServiceRestartProcedure = "/jukebox.v1.AdminService/Restart"
+	// AdminServicePreviousProcedure is the fully-qualified name of the AdminService's Previous RPC.
+	AdminServicePreviousProcedure = "/jukebox.v1.AdminService/Previous"
 	// AdminServiceKickProcedure is the fully-qualified name of the AdminService's Kick RPC.
 	AdminServiceKickProcedure = "/jukebox.v1.AdminService/Kick"
 	// AdminServiceListListenersProcedure is the fully-qualified name of the AdminService's
@@ -49,6 +55,24 @@ const (
 	// AdminServiceStopSessionProcedure is the fully-qualified name of the AdminService's StopSession
 	// RPC.
 	AdminServiceStopSessionProcedure = "/jukebox.v1.AdminService/StopSession"
+	// AdminServiceGetRequestStatsProcedure is the fully-qualified name of the AdminService's
+	// GetRequestStats RPC.
+	AdminServiceGetRequestStatsProcedure = "/jukebox.v1.AdminService/GetRequestStats"
+	// AdminServiceForceEnqueueProcedure is the fully-qualified name of the AdminService's ForceEnqueue
+	// RPC.
+	AdminServiceForceEnqueueProcedure = "/jukebox.v1.AdminService/ForceEnqueue"
+	// AdminServiceCreateSessionProcedure is the fully-qualified name of the AdminService's
+	// CreateSession RPC.
+	AdminServiceCreateSessionProcedure = "/jukebox.v1.AdminService/CreateSession"
+	// AdminServiceStartSessionProcedure is the fully-qualified name of the AdminService's StartSession
+	// RPC.
+	AdminServiceStartSessionProcedure = "/jukebox.v1.AdminService/StartSession"
+	// AdminServiceListSessionsProcedure is the fully-qualified name of the AdminService's ListSessions
+	// RPC.
+	AdminServiceListSessionsProcedure = "/jukebox.v1.AdminService/ListSessions"
+	// AdminServiceUpdateScheduleProcedure is the fully-qualified name of the AdminService's
+	// UpdateSchedule RPC.
+	AdminServiceUpdateScheduleProcedure = "/jukebox.v1.AdminService/UpdateSchedule"
 )
 
 // AdminServiceClient is a client for the jukebox.v1.AdminService service.
@@ -61,12 +85,30 @@ type AdminServiceClient interface {
 	Resume(context.Context, *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error)
 	// スキップ
 	Skip(context.Context, *connect.Request[v1.SkipRequest]) (*connect.Response[v1.SkipResponse], error)
+	// 現在のトラックの再生位置を変更
+	Seek(context.Context, *connect.Request[v1.SeekRequest]) (*connect.Response[v1.SeekResponse], error)
+	// 現在のトラックを最初から再生し直す
+	Restart(context.Context, *connect.Request[v1.RestartRequest]) (*connect.Response[v1.RestartResponse], error)
+	// 直前に再生したトラックをもう一度再生
+	Previous(context.Context, *connect.Request[v1.PreviousRequest]) (*connect.Response[v1.PreviousResponse], error)
 	// リスナーキック
 	Kick(context.Context, *connect.Request[v1.KickRequest]) (*connect.Response[v1.KickResponse], error)
 	// リスナー一覧
 	ListListeners(context.Context, *connect.Request[v1.ListListenersRequest]) (*connect.Response[v1.ListListenersResponse], error)
 	// セッション終了
 	StopSession(context.Context, *connect.Request[v1.StopSessionRequest]) (*connect.Response[v1.StopSessionResponse], error)
+	// リクエスト統計取得
+	GetRequestStats(context.Context, *connect.Request[v1.GetRequestStatsRequest]) (*connect.Response[v1.GetRequestStatsResponse], error)
+	// 楽曲の強制追加（フィルターをバイパス）
+	ForceEnqueue(context.Context, *connect.Request[v1.ForceEnqueueRequest]) (*connect.Response[v1.ForceEnqueueResponse], error)
+	// セッション作成（前回のセッションが終了している場合のみ）
+	CreateSession(context.Context, *connect.Request[v1.CreateSessionRequest]) (*connect.Response[v1.CreateSessionResponse], error)
+	// セッション開始
+	StartSession(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionResponse], error)
+	// セッション一覧（全ルーム）
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// スケジュール変更（終了時間の延長・短縮、開始前セッションの開始時間変更）
+	UpdateSchedule(context.Context, *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the jukebox.v1.AdminService service. By default, it
@@ -104,6 +146,24 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("Skip")),
 			connect.WithClientOptions(opts...),
 		),
+		seek: connect.NewClient[v1.SeekRequest, v1.SeekResponse](
+			httpClient,
+			baseURL+AdminServiceSeekProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("Seek")),
+			connect.WithClientOptions(opts...),
+		),
+		restart: connect.NewClient[v1.RestartRequest, v1.RestartResponse](
+			httpClient,
+			baseURL+AdminServiceRestartProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("Restart")),
+			connect.WithClientOptions(opts...),
+		),
+		previous: connect.NewClient[v1.PreviousRequest, v1.PreviousResponse](
+			httpClient,
+			baseURL+AdminServicePreviousProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("Previous")),
+			connect.WithClientOptions(opts...),
+		),
 		kick: connect.NewClient[v1.KickRequest, v1.KickResponse](
 			httpClient,
 			baseURL+AdminServiceKickProcedure,
@@ -122,18 +182,63 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("StopSession")),
 			connect.WithClientOptions(opts...),
 		),
+		getRequestStats: connect.NewClient[v1.GetRequestStatsRequest, v1.GetRequestStatsResponse](
+			httpClient,
+			baseURL+AdminServiceGetRequestStatsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetRequestStats")),
+			connect.WithClientOptions(opts...),
+		),
+		forceEnqueue: connect.NewClient[v1.ForceEnqueueRequest, v1.ForceEnqueueResponse](
+			httpClient,
+			baseURL+AdminServiceForceEnqueueProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ForceEnqueue")),
+			connect.WithClientOptions(opts...),
+		),
+		createSession: connect.NewClient[v1.CreateSessionRequest, v1.CreateSessionResponse](
+			httpClient,
+			baseURL+AdminServiceCreateSessionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateSession")),
+			connect.WithClientOptions(opts...),
+		),
+		startSession: connect.NewClient[v1.StartSessionRequest, v1.StartSessionResponse](
+			httpClient,
+			baseURL+AdminServiceStartSessionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("StartSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AdminServiceListSessionsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSchedule: connect.NewClient[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateScheduleProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateSchedule")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	getStatus     *connect.Client[v1.GetStatusRequest, v1.GetStatusResponse]
-	pause         *connect.Client[v1.PauseRequest, v1.PauseResponse]
-	resume        *connect.Client[v1.ResumeRequest, v1.ResumeResponse]
-	skip          *connect.Client[v1.SkipRequest, v1.SkipResponse]
-	kick          *connect.Client[v1.KickRequest, v1.KickResponse]
-	listListeners *connect.Client[v1.ListListenersRequest, v1.ListListenersResponse]
-	stopSession   *connect.Client[v1.StopSessionRequest, v1.StopSessionResponse]
+	getStatus       *connect.Client[v1.GetStatusRequest, v1.GetStatusResponse]
+	pause           *connect.Client[v1.PauseRequest, v1.PauseResponse]
+	resume          *connect.Client[v1.ResumeRequest, v1.ResumeResponse]
+	skip            *connect.Client[v1.SkipRequest, v1.SkipResponse]
+	seek            *connect.Client[v1.SeekRequest, v1.SeekResponse]
+	restart         *connect.Client[v1.RestartRequest, v1.RestartResponse]
+	previous        *connect.Client[v1.PreviousRequest, v1.PreviousResponse]
+	kick            *connect.Client[v1.KickRequest, v1.KickResponse]
+	listListeners   *connect.Client[v1.ListListenersRequest, v1.ListListenersResponse]
+	stopSession     *connect.Client[v1.StopSessionRequest, v1.StopSessionResponse]
+	getRequestStats *connect.Client[v1.GetRequestStatsRequest, v1.GetRequestStatsResponse]
+	forceEnqueue    *connect.Client[v1.ForceEnqueueRequest, v1.ForceEnqueueResponse]
+	createSession   *connect.Client[v1.CreateSessionRequest, v1.CreateSessionResponse]
+	startSession    *connect.Client[v1.StartSessionRequest, v1.StartSessionResponse]
+	listSessions    *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	updateSchedule  *connect.Client[v1.UpdateScheduleRequest, v1.UpdateScheduleResponse]
 }
 
 // GetStatus calls jukebox.v1.AdminService.GetStatus.
@@ -156,6 +261,21 @@ func (c *adminServiceClient) Skip(ctx context.Context, req *connect.Request[v1.S
 	return c.skip.CallUnary(ctx, req)
 }
 
+// Seek calls jukebox.v1.AdminService.Seek.
+func (c *adminServiceClient) Seek(ctx context.Context, req *connect.Request[v1.SeekRequest]) (*connect.Response[v1.SeekResponse], error) {
+	return c.seek.CallUnary(ctx, req)
+}
+
+// Restart calls jukebox.v1.AdminService.Restart.
+func (c *adminServiceClient) Restart(ctx context.Context, req *connect.Request[v1.RestartRequest]) (*connect.Response[v1.RestartResponse], error) {
+	return c.restart.CallUnary(ctx, req)
+}
+
+// Previous calls jukebox.v1.AdminService.Previous.
+func (c *adminServiceClient) Previous(ctx context.Context, req *connect.Request[v1.PreviousRequest]) (*connect.Response[v1.PreviousResponse], error) {
+	return c.previous.CallUnary(ctx, req)
+}
+
 // Kick calls jukebox.v1.AdminService.Kick.
 func (c *adminServiceClient) Kick(ctx context.Context, req *connect.Request[v1.KickRequest]) (*connect.Response[v1.KickResponse], error) {
 	return c.kick.CallUnary(ctx, req)
@@ -171,6 +291,36 @@ func (c *adminServiceClient) StopSession(ctx context.Context, req *connect.Reque
 	return c.stopSession.CallUnary(ctx, req)
 }
 
+// GetRequestStats calls jukebox.v1.AdminService.GetRequestStats.
+func (c *adminServiceClient) GetRequestStats(ctx context.Context, req *connect.Request[v1.GetRequestStatsRequest]) (*connect.Response[v1.GetRequestStatsResponse], error) {
+	return c.getRequestStats.CallUnary(ctx, req)
+}
+
+// ForceEnqueue calls jukebox.v1.AdminService.ForceEnqueue.
+func (c *adminServiceClient) ForceEnqueue(ctx context.Context, req *connect.Request[v1.ForceEnqueueRequest]) (*connect.Response[v1.ForceEnqueueResponse], error) {
+	return c.forceEnqueue.CallUnary(ctx, req)
+}
+
+// CreateSession calls jukebox.v1.AdminService.CreateSession.
+func (c *adminServiceClient) CreateSession(ctx context.Context, req *connect.Request[v1.CreateSessionRequest]) (*connect.Response[v1.CreateSessionResponse], error) {
+	return c.createSession.CallUnary(ctx, req)
+}
+
+// StartSession calls jukebox.v1.AdminService.StartSession.
+func (c *adminServiceClient) StartSession(ctx context.Context, req *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionResponse], error) {
+	return c.startSession.CallUnary(ctx, req)
+}
+
+// ListSessions calls jukebox.v1.AdminService.ListSessions.
+func (c *adminServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// UpdateSchedule calls jukebox.v1.AdminService.UpdateSchedule.
+func (c *adminServiceClient) UpdateSchedule(ctx context.Context, req *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	return c.updateSchedule.CallUnary(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the jukebox.v1.AdminService service.
 type AdminServiceHandler interface {
 	// ステータス取得
@@ -181,12 +331,30 @@ type AdminServiceHandler interface {
 	Resume(context.Context, *connect.Request[v1.ResumeRequest]) (*connect.Response[v1.ResumeResponse], error)
 	// スキップ
 	Skip(context.Context, *connect.Request[v1.SkipRequest]) (*connect.Response[v1.SkipResponse], error)
+	// 現在のトラックの再生位置を変更
+	Seek(context.Context, *connect.Request[v1.SeekRequest]) (*connect.Response[v1.SeekResponse], error)
+	// 現在のトラックを最初から再生し直す
+	Restart(context.Context, *connect.Request[v1.RestartRequest]) (*connect.Response[v1.RestartResponse], error)
+	// 直前に再生したトラックをもう一度再生
+	Previous(context.Context, *connect.Request[v1.PreviousRequest]) (*connect.Response[v1.PreviousResponse], error)
 	// リスナーキック
 	Kick(context.Context, *connect.Request[v1.KickRequest]) (*connect.Response[v1.KickResponse], error)
 	// リスナー一覧
 	ListListeners(context.Context, *connect.Request[v1.ListListenersRequest]) (*connect.Response[v1.ListListenersResponse], error)
 	// セッション終了
 	StopSession(context.Context, *connect.Request[v1.StopSessionRequest]) (*connect.Response[v1.StopSessionResponse], error)
+	// リクエスト統計取得
+	GetRequestStats(context.Context, *connect.Request[v1.GetRequestStatsRequest]) (*connect.Response[v1.GetRequestStatsResponse], error)
+	// 楽曲の強制追加（フィルターをバイパス）
+	ForceEnqueue(context.Context, *connect.Request[v1.ForceEnqueueRequest]) (*connect.Response[v1.ForceEnqueueResponse], error)
+	// セッション作成（前回のセッションが終了している場合のみ）
+	CreateSession(context.Context, *connect.Request[v1.CreateSessionRequest]) (*connect.Response[v1.CreateSessionResponse], error)
+	// セッション開始
+	StartSession(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionResponse], error)
+	// セッション一覧（全ルーム）
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// スケジュール変更（終了時間の延長・短縮、開始前セッションの開始時間変更）
+	UpdateSchedule(context.Context, *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error)
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -220,6 +388,24 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("Skip")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceSeekHandler := connect.NewUnaryHandler(
+		AdminServiceSeekProcedure,
+		svc.Seek,
+		connect.WithSchema(adminServiceMethods.ByName("Seek")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRestartHandler := connect.NewUnaryHandler(
+		AdminServiceRestartProcedure,
+		svc.Restart,
+		connect.WithSchema(adminServiceMethods.ByName("Restart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServicePreviousHandler := connect.NewUnaryHandler(
+		AdminServicePreviousProcedure,
+		svc.Previous,
+		connect.WithSchema(adminServiceMethods.ByName("Previous")),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceKickHandler := connect.NewUnaryHandler(
 		AdminServiceKickProcedure,
 		svc.Kick,
@@ -238,6 +424,42 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("StopSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceGetRequestStatsHandler := connect.NewUnaryHandler(
+		AdminServiceGetRequestStatsProcedure,
+		svc.GetRequestStats,
+		connect.WithSchema(adminServiceMethods.ByName("GetRequestStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceForceEnqueueHandler := connect.NewUnaryHandler(
+		AdminServiceForceEnqueueProcedure,
+		svc.ForceEnqueue,
+		connect.WithSchema(adminServiceMethods.ByName("ForceEnqueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateSessionHandler := connect.NewUnaryHandler(
+		AdminServiceCreateSessionProcedure,
+		svc.CreateSession,
+		connect.WithSchema(adminServiceMethods.ByName("CreateSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceStartSessionHandler := connect.NewUnaryHandler(
+		AdminServiceStartSessionProcedure,
+		svc.StartSession,
+		connect.WithSchema(adminServiceMethods.ByName("StartSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListSessionsHandler := connect.NewUnaryHandler(
+		AdminServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(adminServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateScheduleHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateScheduleProcedure,
+		svc.UpdateSchedule,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/jukebox.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServiceGetStatusProcedure:
@@ -248,12 +470,30 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceResumeHandler.ServeHTTP(w, r)
 		case AdminServiceSkipProcedure:
 			adminServiceSkipHandler.ServeHTTP(w, r)
+		case AdminServiceSeekProcedure:
+			adminServiceSeekHandler.ServeHTTP(w, r)
+		case AdminServiceRestartProcedure:
+			adminServiceRestartHandler.ServeHTTP(w, r)
+		case AdminServicePreviousProcedure:
+			adminServicePreviousHandler.ServeHTTP(w, r)
 		case AdminServiceKickProcedure:
 			adminServiceKickHandler.ServeHTTP(w, r)
 		case AdminServiceListListenersProcedure:
 			adminServiceListListenersHandler.ServeHTTP(w, r)
 		case AdminServiceStopSessionProcedure:
 			adminServiceStopSessionHandler.ServeHTTP(w, r)
+		case AdminServiceGetRequestStatsProcedure:
+			adminServiceGetRequestStatsHandler.ServeHTTP(w, r)
+		case AdminServiceForceEnqueueProcedure:
+			adminServiceForceEnqueueHandler.ServeHTTP(w, r)
+		case AdminServiceCreateSessionProcedure:
+			adminServiceCreateSessionHandler.ServeHTTP(w, r)
+		case AdminServiceStartSessionProcedure:
+			adminServiceStartSessionHandler.ServeHTTP(w, r)
+		case AdminServiceListSessionsProcedure:
+			adminServiceListSessionsHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateScheduleProcedure:
+			adminServiceUpdateScheduleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -279,6 +519,18 @@ func (UnimplementedAdminServiceHandler) Skip(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.Skip is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) Seek(context.Context, *connect.Request[v1.SeekRequest]) (*connect.Response[v1.SeekResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.Seek is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) Restart(context.Context, *connect.Request[v1.RestartRequest]) (*connect.Response[v1.RestartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.Restart is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) Previous(context.Context, *connect.Request[v1.PreviousRequest]) (*connect.Response[v1.PreviousResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.Previous is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) Kick(context.Context, *connect.Request[v1.KickRequest]) (*connect.Response[v1.KickResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.Kick is not implemented"))
 }
@@ -290,3 +542,27 @@ func (UnimplementedAdminServiceHandler) ListListeners(context.Context, *connect.
 func (UnimplementedAdminServiceHandler) StopSession(context.Context, *connect.Request[v1.StopSessionRequest]) (*connect.Response[v1.StopSessionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.StopSession is not implemented"))
 }
+
+func (UnimplementedAdminServiceHandler) GetRequestStats(context.Context, *connect.Request[v1.GetRequestStatsRequest]) (*connect.Response[v1.GetRequestStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.GetRequestStats is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceEnqueue(context.Context, *connect.Request[v1.ForceEnqueueRequest]) (*connect.Response[v1.ForceEnqueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.ForceEnqueue is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateSession(context.Context, *connect.Request[v1.CreateSessionRequest]) (*connect.Response[v1.CreateSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.CreateSession is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) StartSession(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.StartSession is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateSchedule(context.Context, *connect.Request[v1.UpdateScheduleRequest]) (*connect.Response[v1.UpdateScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("jukebox.v1.AdminService.UpdateSchedule is not implemented"))
+}
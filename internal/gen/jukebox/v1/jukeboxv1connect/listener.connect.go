@@ -45,7 +45,7 @@ const (
 
 // ListenerServiceClient is a client for the jukebox.v1.ListenerService service.
 type ListenerServiceClient interface {
-	// セッション参加
+	// セッション参加（StartSession で開始される前のセッションには参加できません）
 	Join(context.Context, *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error)
 	// 選曲リクエスト
 	RequestTrack(context.Context, *connect.Request[v1.RequestTrackRequest]) (*connect.Response[v1.RequestTrackResponse], error)
@@ -109,7 +109,7 @@ func (c *listenerServiceClient) SubscribeNotifications(ctx context.Context, req
 
 // ListenerServiceHandler is an implementation of the jukebox.v1.ListenerService service.
 type ListenerServiceHandler interface {
-	// セッション参加
+	// セッション参加（StartSession で開始される前のセッションには参加できません）
 	Join(context.Context, *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error)
 	// 選曲リクエスト
 	RequestTrack(context.Context, *connect.Request[v1.RequestTrackRequest]) (*connect.Response[v1.RequestTrackResponse], error)
@@ -25,10 +25,15 @@ const (
 type NotificationType int32
 
 const (
-	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED   NotificationType = 0
-	NotificationType_NOTIFICATION_TYPE_INITIAL_STATE NotificationType = 1 // ストリーム開始時の状態
-	NotificationType_NOTIFICATION_TYPE_CHANGE_STATE  NotificationType = 2 // セッション状態変更
-	NotificationType_NOTIFICATION_TYPE_CHANGE_TRACK  NotificationType = 3 // トラック状態変更
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED      NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_INITIAL_STATE    NotificationType = 1 // ストリーム開始時の状態
+	NotificationType_NOTIFICATION_TYPE_CHANGE_STATE     NotificationType = 2 // セッション状態変更
+	NotificationType_NOTIFICATION_TYPE_CHANGE_TRACK     NotificationType = 3 // トラック状態変更
+	NotificationType_NOTIFICATION_TYPE_SCHEDULE_UPDATED NotificationType = 4 // セッションの開始・終了時刻の変更
+	NotificationType_NOTIFICATION_TYPE_DRIFT_DETECTED   NotificationType = 5 // 実際の再生とのズレを検出（管理者のみ、drift.action が alert の場合）
+	NotificationType_NOTIFICATION_TYPE_SEGMENT_STARTED  NotificationType = 6 // タイムセグメント開始
+	NotificationType_NOTIFICATION_TYPE_REQUEST_DROPPED  NotificationType = 7 // リクエスト曲がセッション終了のため削除された（リクエスト者のみ）
+	NotificationType_NOTIFICATION_TYPE_LAST_CALL        NotificationType = 8 // リクエスト受付終了前のラストコール開始
 )
 
 // Enum value maps for NotificationType.
@@ -38,12 +43,22 @@ var (
 		1: "NOTIFICATION_TYPE_INITIAL_STATE",
 		2: "NOTIFICATION_TYPE_CHANGE_STATE",
 		3: "NOTIFICATION_TYPE_CHANGE_TRACK",
+		4: "NOTIFICATION_TYPE_SCHEDULE_UPDATED",
+		5: "NOTIFICATION_TYPE_DRIFT_DETECTED",
+		6: "NOTIFICATION_TYPE_SEGMENT_STARTED",
+		7: "NOTIFICATION_TYPE_REQUEST_DROPPED",
+		8: "NOTIFICATION_TYPE_LAST_CALL",
 	}
 	NotificationType_value = map[string]int32{
-		"NOTIFICATION_TYPE_UNSPECIFIED":   0,
-		"NOTIFICATION_TYPE_INITIAL_STATE": 1,
-		"NOTIFICATION_TYPE_CHANGE_STATE":  2,
-		"NOTIFICATION_TYPE_CHANGE_TRACK":  3,
+		"NOTIFICATION_TYPE_UNSPECIFIED":      0,
+		"NOTIFICATION_TYPE_INITIAL_STATE":    1,
+		"NOTIFICATION_TYPE_CHANGE_STATE":     2,
+		"NOTIFICATION_TYPE_CHANGE_TRACK":     3,
+		"NOTIFICATION_TYPE_SCHEDULE_UPDATED": 4,
+		"NOTIFICATION_TYPE_DRIFT_DETECTED":   5,
+		"NOTIFICATION_TYPE_SEGMENT_STARTED":  6,
+		"NOTIFICATION_TYPE_REQUEST_DROPPED":  7,
+		"NOTIFICATION_TYPE_LAST_CALL":        8,
 	}
 )
 
@@ -83,6 +98,7 @@ const (
 	TrackState_TRACK_STATE_SKIPPED     TrackState = 2 // トラック再生スキップ
 	TrackState_TRACK_STATE_PLAYING     TrackState = 3 // トラック再生中
 	TrackState_TRACK_STATE_PAUSED      TrackState = 4 // トラック一時停止中
+	TrackState_TRACK_STATE_DROPPED     TrackState = 5 // セッション終了のため再生されずに削除
 )
 
 // Enum value maps for TrackState.
@@ -93,6 +109,7 @@ var (
 		2: "TRACK_STATE_SKIPPED",
 		3: "TRACK_STATE_PLAYING",
 		4: "TRACK_STATE_PAUSED",
+		5: "TRACK_STATE_DROPPED",
 	}
 	TrackState_value = map[string]int32{
 		"TRACK_STATE_UNSPECIFIED": 0,
@@ -100,6 +117,7 @@ var (
 		"TRACK_STATE_SKIPPED":     2,
 		"TRACK_STATE_PLAYING":     3,
 		"TRACK_STATE_PAUSED":      4,
+		"TRACK_STATE_DROPPED":     5,
 	}
 )
 
@@ -198,8 +216,10 @@ type JoinRequest struct {
 	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	// 外部ユーザーID（Bot連携用、任意）
 	ExternalUserId string `protobuf:"bytes,2,opt,name=external_user_id,json=externalUserId,proto3" json:"external_user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId     string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinRequest) Reset() {
@@ -246,6 +266,13 @@ func (x *JoinRequest) GetExternalUserId() string {
 	return ""
 }
 
+func (x *JoinRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type JoinResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// リスナーID（UUID）
@@ -296,7 +323,9 @@ type RequestTrackRequest struct {
 	// リスナーID（UUID）
 	ListenerId string `protobuf:"bytes,1,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
 	// Spotify Track ID
-	TrackId       string `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	TrackId string `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// 対象のセッションID（ルーム名も可。省略時はリスナーが参加しているセッション）
+	SessionId     string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -345,6 +374,13 @@ func (x *RequestTrackRequest) GetTrackId() string {
 	return ""
 }
 
+func (x *RequestTrackRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 type RequestTrackResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// リクエスト成功フラグ
@@ -409,7 +445,11 @@ func (x *RequestTrackResponse) GetCode() string {
 }
 
 type SubscribeNotificationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 対象のセッションID（ルーム名も可。セッションが1つのみの場合は省略可）
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// 購読するリスナーのID（任意。指定すると管理者向けの通知も受け取り、購読中は在室中のリスナーとしてBGMの選曲に反映）
+	ListenerId    string `protobuf:"bytes,2,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -444,6 +484,20 @@ func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
 	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *SubscribeNotificationsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SubscribeNotificationsRequest) GetListenerId() string {
+	if x != nil {
+		return x.ListenerId
+	}
+	return ""
+}
+
 type Notification struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 通知タイプ
@@ -453,7 +507,9 @@ type Notification struct {
 	// セッション情報
 	SessionInfo *SessionInfo `protobuf:"bytes,3,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
 	// トラック情報
-	TrackInfo     *TrackInfo `protobuf:"bytes,4,opt,name=track_info,json=trackInfo,proto3" json:"track_info,omitempty"`
+	TrackInfo *TrackInfo `protobuf:"bytes,4,opt,name=track_info,json=trackInfo,proto3" json:"track_info,omitempty"`
+	// 実際の再生とのズレ（DRIFT_DETECTED の場合のみ）
+	Drift         *PlaybackDrift `protobuf:"bytes,5,opt,name=drift,proto3" json:"drift,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -516,6 +572,76 @@ func (x *Notification) GetTrackInfo() *TrackInfo {
 	return nil
 }
 
+func (x *Notification) GetDrift() *PlaybackDrift {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+type PlaybackDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// サーバーの再生位置と実際の再生位置の差（ミリ秒、正の値はサーバーが先行）
+	DriftMs int64 `protobuf:"varint,1,opt,name=drift_ms,json=driftMs,proto3" json:"drift_ms,omitempty"`
+	// 計測時刻（RFC3339形式）
+	MeasuredAt string `protobuf:"bytes,2,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"`
+	// 許容範囲を超えているか
+	Exceeded      bool `protobuf:"varint,3,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaybackDrift) Reset() {
+	*x = PlaybackDrift{}
+	mi := &file_jukebox_v1_listener_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackDrift) ProtoMessage() {}
+
+func (x *PlaybackDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_listener_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackDrift.ProtoReflect.Descriptor instead.
+func (*PlaybackDrift) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlaybackDrift) GetDriftMs() int64 {
+	if x != nil {
+		return x.DriftMs
+	}
+	return 0
+}
+
+func (x *PlaybackDrift) GetMeasuredAt() string {
+	if x != nil {
+		return x.MeasuredAt
+	}
+	return ""
+}
+
+func (x *PlaybackDrift) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
 type SessionInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// セッションID
@@ -534,13 +660,21 @@ type SessionInfo struct {
 	State SessionState `protobuf:"varint,7,opt,name=state,proto3,enum=jukebox.v1.SessionState" json:"state,omitempty"`
 	// リクエスト受付状態
 	AcceptingRequests bool `protobuf:"varint,8,opt,name=accepting_requests,json=acceptingRequests,proto3" json:"accepting_requests,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// ルーム名（ルームが設定されていない場合は空文字列）
+	Room string `protobuf:"bytes,9,opt,name=room,proto3" json:"room,omitempty"`
+	// 現在のタイムセグメント（セグメントが始まっていない場合は未設定）
+	Segment *SegmentInfo `protobuf:"bytes,10,opt,name=segment,proto3" json:"segment,omitempty"`
+	// ラストコール中か（まもなくリクエスト受付が終了する）
+	LastCall bool `protobuf:"varint,11,opt,name=last_call,json=lastCall,proto3" json:"last_call,omitempty"`
+	// リクエスト受付終了までの残り時間（秒、ラストコール中のみ）
+	LastCallRemainingSeconds int32 `protobuf:"varint,12,opt,name=last_call_remaining_seconds,json=lastCallRemainingSeconds,proto3" json:"last_call_remaining_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *SessionInfo) Reset() {
 	*x = SessionInfo{}
-	mi := &file_jukebox_v1_listener_proto_msgTypes[6]
+	mi := &file_jukebox_v1_listener_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +686,7 @@ func (x *SessionInfo) String() string {
 func (*SessionInfo) ProtoMessage() {}
 
 func (x *SessionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_jukebox_v1_listener_proto_msgTypes[6]
+	mi := &file_jukebox_v1_listener_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +699,7 @@ func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{6}
+	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SessionInfo) GetSessionId() string {
@@ -624,6 +758,98 @@ func (x *SessionInfo) GetAcceptingRequests() bool {
 	return false
 }
 
+func (x *SessionInfo) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetSegment() *SegmentInfo {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetLastCall() bool {
+	if x != nil {
+		return x.LastCall
+	}
+	return false
+}
+
+func (x *SessionInfo) GetLastCallRemainingSeconds() int32 {
+	if x != nil {
+		return x.LastCallRemainingSeconds
+	}
+	return 0
+}
+
+// タイムセグメント情報
+type SegmentInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// セグメント名
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 開始時刻（RFC3339形式）
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// セグメント中はユーザーリクエストを一時停止するか
+	RequestsPaused bool `protobuf:"varint,3,opt,name=requests_paused,json=requestsPaused,proto3" json:"requests_paused,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SegmentInfo) Reset() {
+	*x = SegmentInfo{}
+	mi := &file_jukebox_v1_listener_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentInfo) ProtoMessage() {}
+
+func (x *SegmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jukebox_v1_listener_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentInfo.ProtoReflect.Descriptor instead.
+func (*SegmentInfo) Descriptor() ([]byte, []int) {
+	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SegmentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SegmentInfo) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *SegmentInfo) GetRequestsPaused() bool {
+	if x != nil {
+		return x.RequestsPaused
+	}
+	return false
+}
+
 type TrackInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Spotify Track ID
@@ -642,7 +868,7 @@ type TrackInfo struct {
 	RequesterExternalUserId string `protobuf:"bytes,7,opt,name=requester_external_user_id,json=requesterExternalUserId,proto3" json:"requester_external_user_id,omitempty"`
 	// セッションプレイリストURL
 	PlaylistUrl string `protobuf:"bytes,8,opt,name=playlist_url,json=playlistUrl,proto3" json:"playlist_url,omitempty"`
-	// 選曲者タイプ (user, opening, ending, bgm)
+	// 選曲者タイプ (user, opening, ending, bgm, admin, jingle, segment)
 	RequesterType string `protobuf:"bytes,9,opt,name=requester_type,json=requesterType,proto3" json:"requester_type,omitempty"`
 	// 現在楽曲の残り時間（秒）
 	RemainingSeconds int32 `protobuf:"varint,10,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
@@ -654,7 +880,7 @@ type TrackInfo struct {
 
 func (x *TrackInfo) Reset() {
 	*x = TrackInfo{}
-	mi := &file_jukebox_v1_listener_proto_msgTypes[7]
+	mi := &file_jukebox_v1_listener_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +892,7 @@ func (x *TrackInfo) String() string {
 func (*TrackInfo) ProtoMessage() {}
 
 func (x *TrackInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_jukebox_v1_listener_proto_msgTypes[7]
+	mi := &file_jukebox_v1_listener_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +905,7 @@ func (x *TrackInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackInfo.ProtoReflect.Descriptor instead.
 func (*TrackInfo) Descriptor() ([]byte, []int) {
-	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{7}
+	return file_jukebox_v1_listener_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TrackInfo) GetTrackId() string {
@@ -764,29 +990,43 @@ var File_jukebox_v1_listener_proto protoreflect.FileDescriptor
 const file_jukebox_v1_listener_proto_rawDesc = "" +
 	"\n" +
 	"\x19jukebox/v1/listener.proto\x12\n" +
-	"jukebox.v1\"Z\n" +
+	"jukebox.v1\"y\n" +
 	"\vJoinRequest\x12!\n" +
 	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12(\n" +
-	"\x10external_user_id\x18\x02 \x01(\tR\x0eexternalUserId\"/\n" +
+	"\x10external_user_id\x18\x02 \x01(\tR\x0eexternalUserId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\"/\n" +
 	"\fJoinResponse\x12\x1f\n" +
 	"\vlistener_id\x18\x01 \x01(\tR\n" +
-	"listenerId\"Q\n" +
+	"listenerId\"p\n" +
 	"\x13RequestTrackRequest\x12\x1f\n" +
 	"\vlistener_id\x18\x01 \x01(\tR\n" +
 	"listenerId\x12\x19\n" +
-	"\btrack_id\x18\x02 \x01(\tR\atrackId\"^\n" +
+	"\btrack_id\x18\x02 \x01(\tR\atrackId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\"^\n" +
 	"\x14RequestTrackResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code\"\x1f\n" +
-	"\x1dSubscribeNotificationsRequest\"\xd3\x01\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"_\n" +
+	"\x1dSubscribeNotificationsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vlistener_id\x18\x02 \x01(\tR\n" +
+	"listenerId\"\x84\x02\n" +
 	"\fNotification\x120\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1c.jukebox.v1.NotificationTypeR\x04type\x12\x1f\n" +
 	"\vsequence_no\x18\x02 \x01(\x04R\n" +
 	"sequenceNo\x12:\n" +
 	"\fsession_info\x18\x03 \x01(\v2\x17.jukebox.v1.SessionInfoR\vsessionInfo\x124\n" +
 	"\n" +
-	"track_info\x18\x04 \x01(\v2\x15.jukebox.v1.TrackInfoR\ttrackInfo\"\xcf\x02\n" +
+	"track_info\x18\x04 \x01(\v2\x15.jukebox.v1.TrackInfoR\ttrackInfo\x12/\n" +
+	"\x05drift\x18\x05 \x01(\v2\x19.jukebox.v1.PlaybackDriftR\x05drift\"g\n" +
+	"\rPlaybackDrift\x12\x19\n" +
+	"\bdrift_ms\x18\x01 \x01(\x03R\adriftMs\x12\x1f\n" +
+	"\vmeasured_at\x18\x02 \x01(\tR\n" +
+	"measuredAt\x12\x1a\n" +
+	"\bexceeded\x18\x03 \x01(\bR\bexceeded\"\xf2\x03\n" +
 	"\vSessionInfo\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12#\n" +
@@ -796,7 +1036,17 @@ const file_jukebox_v1_listener_proto_rawDesc = "" +
 	"\x14scheduled_start_time\x18\x05 \x01(\tR\x12scheduledStartTime\x12,\n" +
 	"\x12scheduled_end_time\x18\x06 \x01(\tR\x10scheduledEndTime\x12.\n" +
 	"\x05state\x18\a \x01(\x0e2\x18.jukebox.v1.SessionStateR\x05state\x12-\n" +
-	"\x12accepting_requests\x18\b \x01(\bR\x11acceptingRequests\"\x93\x03\n" +
+	"\x12accepting_requests\x18\b \x01(\bR\x11acceptingRequests\x12\x12\n" +
+	"\x04room\x18\t \x01(\tR\x04room\x121\n" +
+	"\asegment\x18\n" +
+	" \x01(\v2\x17.jukebox.v1.SegmentInfoR\asegment\x12\x1b\n" +
+	"\tlast_call\x18\v \x01(\bR\blastCall\x12=\n" +
+	"\x1blast_call_remaining_seconds\x18\f \x01(\x05R\x18lastCallRemainingSeconds\"i\n" +
+	"\vSegmentInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12'\n" +
+	"\x0frequests_paused\x18\x03 \x01(\bR\x0erequestsPaused\"\x93\x03\n" +
 	"\tTrackInfo\x12\x19\n" +
 	"\btrack_id\x18\x01 \x01(\tR\atrackId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -809,19 +1059,25 @@ const file_jukebox_v1_listener_proto_rawDesc = "" +
 	"\x0erequester_type\x18\t \x01(\tR\rrequesterType\x12+\n" +
 	"\x11remaining_seconds\x18\n" +
 	" \x01(\x05R\x10remainingSeconds\x12,\n" +
-	"\x05state\x18\v \x01(\x0e2\x16.jukebox.v1.TrackStateR\x05state*\xa2\x01\n" +
+	"\x05state\x18\v \x01(\x0e2\x16.jukebox.v1.TrackStateR\x05state*\xdf\x02\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_INITIAL_STATE\x10\x01\x12\"\n" +
 	"\x1eNOTIFICATION_TYPE_CHANGE_STATE\x10\x02\x12\"\n" +
-	"\x1eNOTIFICATION_TYPE_CHANGE_TRACK\x10\x03*\x8c\x01\n" +
+	"\x1eNOTIFICATION_TYPE_CHANGE_TRACK\x10\x03\x12&\n" +
+	"\"NOTIFICATION_TYPE_SCHEDULE_UPDATED\x10\x04\x12$\n" +
+	" NOTIFICATION_TYPE_DRIFT_DETECTED\x10\x05\x12%\n" +
+	"!NOTIFICATION_TYPE_SEGMENT_STARTED\x10\x06\x12%\n" +
+	"!NOTIFICATION_TYPE_REQUEST_DROPPED\x10\a\x12\x1f\n" +
+	"\x1bNOTIFICATION_TYPE_LAST_CALL\x10\b*\xa5\x01\n" +
 	"\n" +
 	"TrackState\x12\x1b\n" +
 	"\x17TRACK_STATE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TRACK_STATE_STARTED\x10\x01\x12\x17\n" +
 	"\x13TRACK_STATE_SKIPPED\x10\x02\x12\x17\n" +
 	"\x13TRACK_STATE_PLAYING\x10\x03\x12\x16\n" +
-	"\x12TRACK_STATE_PAUSED\x10\x04*\xdb\x01\n" +
+	"\x12TRACK_STATE_PAUSED\x10\x04\x12\x17\n" +
+	"\x13TRACK_STATE_DROPPED\x10\x05*\xdb\x01\n" +
 	"\fSessionState\x12\x1d\n" +
 	"\x19SESSION_STATE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15SESSION_STATE_WAITING\x10\x01\x12\x19\n" +
@@ -851,7 +1107,7 @@ func file_jukebox_v1_listener_proto_rawDescGZIP() []byte {
 }
 
 var file_jukebox_v1_listener_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_jukebox_v1_listener_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_jukebox_v1_listener_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_jukebox_v1_listener_proto_goTypes = []any{
 	(NotificationType)(0),                 // 0: jukebox.v1.NotificationType
 	(TrackState)(0),                       // 1: jukebox.v1.TrackState
@@ -862,26 +1118,30 @@ var file_jukebox_v1_listener_proto_goTypes = []any{
 	(*RequestTrackResponse)(nil),          // 6: jukebox.v1.RequestTrackResponse
 	(*SubscribeNotificationsRequest)(nil), // 7: jukebox.v1.SubscribeNotificationsRequest
 	(*Notification)(nil),                  // 8: jukebox.v1.Notification
-	(*SessionInfo)(nil),                   // 9: jukebox.v1.SessionInfo
-	(*TrackInfo)(nil),                     // 10: jukebox.v1.TrackInfo
+	(*PlaybackDrift)(nil),                 // 9: jukebox.v1.PlaybackDrift
+	(*SessionInfo)(nil),                   // 10: jukebox.v1.SessionInfo
+	(*SegmentInfo)(nil),                   // 11: jukebox.v1.SegmentInfo
+	(*TrackInfo)(nil),                     // 12: jukebox.v1.TrackInfo
 }
 var file_jukebox_v1_listener_proto_depIdxs = []int32{
 	0,  // 0: jukebox.v1.Notification.type:type_name -> jukebox.v1.NotificationType
-	9,  // 1: jukebox.v1.Notification.session_info:type_name -> jukebox.v1.SessionInfo
-	10, // 2: jukebox.v1.Notification.track_info:type_name -> jukebox.v1.TrackInfo
-	2,  // 3: jukebox.v1.SessionInfo.state:type_name -> jukebox.v1.SessionState
-	1,  // 4: jukebox.v1.TrackInfo.state:type_name -> jukebox.v1.TrackState
-	3,  // 5: jukebox.v1.ListenerService.Join:input_type -> jukebox.v1.JoinRequest
-	5,  // 6: jukebox.v1.ListenerService.RequestTrack:input_type -> jukebox.v1.RequestTrackRequest
-	7,  // 7: jukebox.v1.ListenerService.SubscribeNotifications:input_type -> jukebox.v1.SubscribeNotificationsRequest
-	4,  // 8: jukebox.v1.ListenerService.Join:output_type -> jukebox.v1.JoinResponse
-	6,  // 9: jukebox.v1.ListenerService.RequestTrack:output_type -> jukebox.v1.RequestTrackResponse
-	8,  // 10: jukebox.v1.ListenerService.SubscribeNotifications:output_type -> jukebox.v1.Notification
-	8,  // [8:11] is the sub-list for method output_type
-	5,  // [5:8] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	10, // 1: jukebox.v1.Notification.session_info:type_name -> jukebox.v1.SessionInfo
+	12, // 2: jukebox.v1.Notification.track_info:type_name -> jukebox.v1.TrackInfo
+	9,  // 3: jukebox.v1.Notification.drift:type_name -> jukebox.v1.PlaybackDrift
+	2,  // 4: jukebox.v1.SessionInfo.state:type_name -> jukebox.v1.SessionState
+	11, // 5: jukebox.v1.SessionInfo.segment:type_name -> jukebox.v1.SegmentInfo
+	1,  // 6: jukebox.v1.TrackInfo.state:type_name -> jukebox.v1.TrackState
+	3,  // 7: jukebox.v1.ListenerService.Join:input_type -> jukebox.v1.JoinRequest
+	5,  // 8: jukebox.v1.ListenerService.RequestTrack:input_type -> jukebox.v1.RequestTrackRequest
+	7,  // 9: jukebox.v1.ListenerService.SubscribeNotifications:input_type -> jukebox.v1.SubscribeNotificationsRequest
+	4,  // 10: jukebox.v1.ListenerService.Join:output_type -> jukebox.v1.JoinResponse
+	6,  // 11: jukebox.v1.ListenerService.RequestTrack:output_type -> jukebox.v1.RequestTrackResponse
+	8,  // 12: jukebox.v1.ListenerService.SubscribeNotifications:output_type -> jukebox.v1.Notification
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_jukebox_v1_listener_proto_init() }
@@ -895,7 +1155,7 @@ func file_jukebox_v1_listener_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jukebox_v1_listener_proto_rawDesc), len(file_jukebox_v1_listener_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,175 @@
+// Package catalog loads local catalogs of curated tracks.
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/osa030/19box/internal/domain/track"
+	"github.com/osa030/19box/internal/infra/spotify"
+)
+
+// tagSeparator separates the tags of a track in a CSV catalog.
+const tagSeparator = "|"
+
+// Entry is a track of the catalog.
+type Entry struct {
+	TrackID string   `json:"track_id"`          // Spotify track ID (a URI or URL in the file is converted)
+	Tags    []string `json:"tags"`              // Tags, lowercased
+	Energy  *float64 `json:"energy,omitempty"`  // Energy level (0.0-1.0, nil if unknown)
+	Tempo   *float64 `json:"tempo,omitempty"`   // Beats per minute (nil if unknown)
+	Valence *float64 `json:"valence,omitempty"` // Musical positiveness (0.0-1.0, nil if unknown)
+}
+
+// Attributes returns the audio attributes of the entry.
+// Returns false if the entry has no energy level; a missing tempo or valence is left unknown.
+func (e Entry) Attributes() (track.AudioAttributes, bool) {
+	if e.Energy == nil {
+		return track.AudioAttributes{}, false
+	}
+	return track.AudioAttributes{Energy: *e.Energy, Tempo: e.Tempo, Valence: e.Valence}, true
+}
+
+// HasTag reports whether the entry has the tag (case-insensitive).
+func (e Entry) HasTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, t := range e.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Load reads a catalog file. The format is chosen by the extension:
+//
+//   - .csv: a header row with the columns track_id (required), tags (separated by "|"),
+//     energy, tempo and valence
+//   - .json: an array of objects with the fields track_id, tags, energy, tempo and valence
+func Load(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open catalog")
+	}
+	defer f.Close()
+
+	var entries []Entry
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		entries, err = parseCSV(f)
+	case ".json":
+		entries, err = parseJSON(f)
+	default:
+		return nil, errors.Newf("unsupported catalog format: %s (use .csv or .json)", ext)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse catalog %s", path)
+	}
+	return entries, nil
+}
+
+func parseCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read header")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["track_id"]; !ok {
+		return nil, errors.New("track_id column is required")
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var entries []Entry
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+
+		e := Entry{TrackID: field(record, "track_id")}
+		if tags := field(record, "tags"); tags != "" {
+			e.Tags = strings.Split(tags, tagSeparator)
+		}
+		numbers := []struct {
+			name string
+			dst  **float64
+		}{{"energy", &e.Energy}, {"tempo", &e.Tempo}, {"valence", &e.Valence}}
+		for _, n := range numbers {
+			value := field(record, n.name)
+			if value == "" {
+				continue
+			}
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d: invalid %s", line, n.name)
+			}
+			*n.dst = &v
+		}
+		if err := e.normalize(); err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func parseJSON(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if err := entries[i].normalize(); err != nil {
+			return nil, errors.Wrapf(err, "entry %d", i+1)
+		}
+	}
+	return entries, nil
+}
+
+// normalize lowercases the tags, drops empty ones and checks the entry.
+func (e *Entry) normalize() error {
+	e.TrackID = spotify.ParseTrackID(e.TrackID)
+	if e.TrackID == "" {
+		return errors.New("track_id is empty")
+	}
+	if e.Energy != nil && (*e.Energy < 0 || *e.Energy > 1) {
+		return errors.Newf("energy must be between 0 and 1: %v", *e.Energy)
+	}
+	if e.Tempo != nil && *e.Tempo <= 0 {
+		return errors.Newf("tempo must be positive: %v", *e.Tempo)
+	}
+	if e.Valence != nil && (*e.Valence < 0 || *e.Valence > 1) {
+		return errors.Newf("valence must be between 0 and 1: %v", *e.Valence)
+	}
+
+	tags := make([]string, 0, len(e.Tags))
+	for _, t := range e.Tags {
+		if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	e.Tags = tags
+	return nil
+}
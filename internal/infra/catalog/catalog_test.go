@@ -0,0 +1,108 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func writeCatalog(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoad_CSV(t *testing.T) {
+	path := writeCatalog(t, "catalog.csv", `track_id,tags,energy
+0ee1DiZF94NSqqpG0XHUzH,Jazz| Chill ,0.3
+spotify:track:4uLU6hMCjMI75M1A2tKUQC,,
+`)
+
+	entries, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "0ee1DiZF94NSqqpG0XHUzH", entries[0].TrackID)
+	assert.Equal(t, []string{"jazz", "chill"}, entries[0].Tags)
+	require.NotNil(t, entries[0].Energy)
+	assert.Equal(t, 0.3, *entries[0].Energy)
+	assert.True(t, entries[0].HasTag("Chill"))
+
+	assert.Equal(t, "4uLU6hMCjMI75M1A2tKUQC", entries[1].TrackID)
+	assert.Empty(t, entries[1].Tags)
+	assert.Nil(t, entries[1].Energy)
+}
+
+func TestLoad_JSON(t *testing.T) {
+	path := writeCatalog(t, "catalog.json", `[
+  {"track_id": "0ee1DiZF94NSqqpG0XHUzH", "tags": ["Jazz"], "energy": 0.8},
+  {"track_id": "4uLU6hMCjMI75M1A2tKUQC"}
+]`)
+
+	entries, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, []string{"jazz"}, entries[0].Tags)
+	require.NotNil(t, entries[0].Energy)
+	assert.Equal(t, 0.8, *entries[0].Energy)
+	assert.Nil(t, entries[1].Energy)
+}
+
+func TestLoad_Attributes(t *testing.T) {
+	path := writeCatalog(t, "catalog.csv", `track_id,energy,tempo,valence
+a,0.7,128,0.9
+b,0.2,,
+c,,120,0.5
+`)
+
+	entries, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	a, ok := entries[0].Attributes()
+	require.True(t, ok)
+	tempo, valence := 128.0, 0.9
+	assert.Equal(t, track.AudioAttributes{Energy: 0.7, Tempo: &tempo, Valence: &valence}, a)
+
+	b, ok := entries[1].Attributes()
+	require.True(t, ok)
+	assert.Equal(t, track.AudioAttributes{Energy: 0.2}, b, "a missing tempo or valence is unknown")
+
+	_, ok = entries[2].Attributes()
+	assert.False(t, ok, "an entry without energy has no attributes")
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "unsupported format", file: "catalog.txt", content: "0ee1DiZF94NSqqpG0XHUzH"},
+		{name: "missing track_id column", file: "catalog.csv", content: "id,tags\nabc,jazz\n"},
+		{name: "empty track_id", file: "catalog.csv", content: "track_id,tags\n,jazz\n"},
+		{name: "invalid energy", file: "catalog.csv", content: "track_id,energy\nabc,high\n"},
+		{name: "energy out of range", file: "catalog.json", content: `[{"track_id": "abc", "energy": 1.5}]`},
+		{name: "invalid tempo", file: "catalog.csv", content: "track_id,tempo\nabc,0\n"},
+		{name: "valence out of range", file: "catalog.json", content: `[{"track_id": "abc", "valence": -0.1}]`},
+		{name: "malformed json", file: "catalog.json", content: `{"track_id": "abc"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeCatalog(t, tt.file, tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "catalog.csv"))
+	assert.Error(t, err)
+}
@@ -22,6 +22,8 @@ type Config struct {
 	Filters   map[string]FilterConfig `yaml:"filters"`
 	Messages  MessagesConfig          `yaml:"messages"`
 	Spotify   SpotifyConfig           `yaml:"spotify"`
+	Library   LibraryConfig           `yaml:"library"`
+	Rooms     []RoomConfig            `yaml:"rooms" validate:"dive"`
 }
 
 // ServerConfig represents server configuration.
@@ -44,6 +46,92 @@ type SessionConfig struct {
 	StartTime string   `yaml:"start_time"`
 	EndTime   string   `yaml:"end_time"`
 	Keywords  []string `yaml:"keywords"`
+	// ManualStart disables starting a session from this configuration at boot.
+	// Sessions are then created and started through the admin API.
+	ManualStart bool `yaml:"manual_start"`
+	// Recurrence starts a new session for each occurrence of a schedule
+	// instead of a single session at start_time/end_time.
+	Recurrence *RecurrenceConfig `yaml:"recurrence"`
+	// Segments are timed parts of the session, in chronological order.
+	Segments []SegmentConfig `yaml:"segments" validate:"dive"`
+	// EndingPolicy decides what happens to the queued tracks when the session ends
+	// (the ending playlist is queued, if any): "play_out" plays them, "drop" removes
+	// them, and "fit" keeps those that still let the ending finish by end_time.
+	EndingPolicy string `yaml:"ending_policy" default:"drop" validate:"omitempty,oneof=play_out drop fit"`
+	// RejectOverrun rejects user requests that would still be playing when the
+	// ending playlist has to start, instead of only those that would start after it.
+	RejectOverrun bool `yaml:"reject_overrun"`
+	// LastCall warns listeners before requests close.
+	LastCall LastCallConfig `yaml:"last_call"`
+}
+
+// LastCallConfig represents the last call before the acceptance deadline.
+type LastCallConfig struct {
+	// LeadMinutes is how long before the acceptance deadline the last call starts (0 disables).
+	LeadMinutes int `yaml:"lead_minutes" validate:"gte=0"`
+	// Filters are applied to user requests in addition to the session filters during the last call,
+	// e.g. a duration_limit_filter with a shorter max_minutes.
+	Filters map[string]FilterConfig `yaml:"filters"`
+}
+
+// SegmentConfig represents a timed segment of a session (e.g. a themed set).
+// A segment lasts until the next segment starts or the session ends.
+type SegmentConfig struct {
+	Name string `yaml:"name" validate:"required"`
+	// At is the segment start: a time of day ("21:00") on the day the session
+	// starts (or the day after, if earlier than the start), or an RFC3339 time.
+	At string `yaml:"at" validate:"required"`
+	// Playlist is queued when the segment starts (optional).
+	Playlist PlaylistEntryConfig `yaml:"playlist"`
+	// Mode is "preempt" to play the playlist right after the current track,
+	// or "append" to play it after the tracks already queued.
+	Mode string `yaml:"mode" default:"append" validate:"omitempty,oneof=preempt append"`
+	// BGMProviders replace the BGM providers during the segment. Empty uses the session's providers.
+	BGMProviders []ProviderConfig `yaml:"bgm_providers" validate:"dive"`
+	// PauseRequests rejects user requests during the segment.
+	PauseRequests bool `yaml:"pause_requests"`
+}
+
+// ParseAt returns the start of the segment for a session that starts at sessionStart.
+func (s SegmentConfig) ParseAt(sessionStart time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s.At); err == nil {
+		return t, nil
+	}
+
+	clock, err := time.Parse("15:04", s.At)
+	if err != nil {
+		return time.Time{}, errors.Newf("segment %s: at must be HH:MM or RFC3339: %s", s.Name, s.At)
+	}
+	y, mo, d := sessionStart.Date()
+	t := time.Date(y, mo, d, clock.Hour(), clock.Minute(), 0, 0, sessionStart.Location())
+	if t.Before(sessionStart) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+// RecurrenceConfig represents a recurring session schedule.
+type RecurrenceConfig struct {
+	// Cron is a standard 5-field cron expression for the session start times.
+	Cron string `yaml:"cron" validate:"required"`
+	// Duration is the length of each session (e.g. "1h").
+	Duration string `yaml:"duration" validate:"required"`
+	// TimeZone is the IANA time zone the cron expression is evaluated in.
+	TimeZone string `yaml:"time_zone" default:"Local"`
+	// PlaylistName is the playlist name template. Placeholders: {title}, {date}, {time}, {weekday}.
+	PlaylistName string `yaml:"playlist_name" default:"{title} {date}"`
+	// HolidaysFile lists dates (YYYY-MM-DD, one per line) on which no session is held.
+	HolidaysFile string `yaml:"holidays_file"`
+}
+
+// RoomConfig represents a room hosted alongside other rooms in one server.
+// Sections left unset fall back to the top-level configuration.
+type RoomConfig struct {
+	Name      string                  `yaml:"name" validate:"required"`
+	Session   *SessionConfig          `yaml:"session"`
+	Playlists *PlaylistsConfig        `yaml:"playlists"`
+	BGM       *BGMConfig              `yaml:"bgm"`
+	Filters   map[string]FilterConfig `yaml:"filters"`
 }
 
 // AdminConfig represents admin-related configuration.
@@ -56,6 +144,21 @@ type AdminConfig struct {
 type PlaylistsConfig struct {
 	Opening PlaylistEntryConfig `yaml:"opening"`
 	Ending  PlaylistEntryConfig `yaml:"ending"`
+	// Jingle is a playlist of station IDs played between tracks.
+	Jingle JingleConfig `yaml:"jingle"`
+}
+
+// JingleConfig represents the jingle playlist and when its tracks are played.
+// Jingles are played in playlist order, repeating from the start.
+type JingleConfig struct {
+	PlaylistURL string `yaml:"playlist_url"`
+	DisplayName string `yaml:"display_name" default:"Station ID"`
+	// EveryTracks plays a jingle after this many tracks (0 disables).
+	EveryTracks int `yaml:"every_tracks" validate:"gte=0"`
+	// EveryMinutes plays a jingle before the next track once this many minutes have passed since the last one (0 disables).
+	EveryMinutes int `yaml:"every_minutes" validate:"gte=0"`
+	// AtPhaseBoundaries plays a jingle at session start and before and after the opening and ending playlists.
+	AtPhaseBoundaries bool `yaml:"at_phase_boundaries"`
 }
 
 // PlaylistEntryConfig represents a single playlist configuration.
@@ -68,6 +171,31 @@ type PlaylistEntryConfig struct {
 type PlaybackConfig struct {
 	NotificationDelayMs int `yaml:"notification_delay_ms" default:"5000" validate:"gte=0,lte=30000"`
 	GapCorrectionMs     int `yaml:"gap_correction_ms" default:"100" validate:"gte=0,lte=5000"`
+	// Timing of consecutive tracks on the host's player
+	CrossfadeMs   int `yaml:"crossfade_ms" validate:"gte=0,lte=12000"`
+	IntroOffsetMs int `yaml:"intro_offset_ms" validate:"gte=0,lte=30000"`
+	OutroOffsetMs int `yaml:"outro_offset_ms" validate:"gte=0,lte=30000"`
+	// Device makes the server drive a Spotify Connect device instead of only simulating playback.
+	Device DeviceConfig `yaml:"device"`
+	// Drift compares the playback position with the host account's actual playback.
+	Drift DriftConfig `yaml:"drift"`
+}
+
+// DeviceConfig represents Spotify Connect device control configuration.
+type DeviceConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Name is the device name as shown in Spotify. Empty uses the active device.
+	Name string `yaml:"name"`
+}
+
+// DriftConfig represents drift detection configuration.
+// In device control mode drift is always detected and corrected.
+type DriftConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Action is "correct" to align the timers with the host's playback, or "alert" to only report drift.
+	Action         string `yaml:"action" default:"correct" validate:"omitempty,oneof=correct alert"`
+	PollIntervalMs int    `yaml:"poll_interval_ms" default:"5000" validate:"omitempty,gte=1000,lte=60000"`
+	ToleranceMs    int    `yaml:"tolerance_ms" default:"1000" validate:"omitempty,gte=100,lte=10000"`
 }
 
 // BGMConfig represents BGM configuration.
@@ -76,6 +204,69 @@ type BGMConfig struct {
 	RecentArtistCount     int              `yaml:"recent_artist_count" default:"3"`
 	CandidateCount        int              `yaml:"candidate_count" default:"5"`
 	Providers             []ProviderConfig `yaml:"providers" validate:"required,min=1"`
+	// Strategy decides which providers are asked for candidates: "all" asks every
+	// provider, "fallback" asks them in order until enough candidates are found,
+	// "weighted" picks them at random by weight and "round_robin" alternates.
+	Strategy string `yaml:"strategy" default:"all" validate:"omitempty,oneof=all fallback weighted round_robin"`
+	// Seed tracks for recommendations are drawn from the played tracks and the
+	// queued requests. Providers that declare their own seed count get that many.
+	SeedTrackCount int     `yaml:"seed_track_count" default:"3" validate:"gte=0"`
+	SeedUserWeight float64 `yaml:"seed_user_weight" default:"2" validate:"gte=0"`
+	SeedDecay      float64 `yaml:"seed_decay" default:"0.8" validate:"gte=0,lte=1"`
+	// PrefetchCount is the number of BGM candidates fetched and filtered in the
+	// background, ready for the next refill. 0 fetches them only when needed.
+	PrefetchCount int `yaml:"prefetch_count" default:"5" validate:"gte=0"`
+	// BGM keeps at least BufferTracks tracks and BufferSec seconds of tracks queued,
+	// topped up as tracks start. When both are 0, BGM only fills an empty queue.
+	BufferTracks int `yaml:"buffer_tracks" validate:"gte=0"`
+	BufferSec    int `yaml:"buffer_sec" validate:"gte=0"`
+	// YieldToRequests queues user requests ahead of the BGM tracks at the end of
+	// the queue, and retracts the BGM tracks the buffer no longer needs.
+	// A pointer so that an explicit false is not replaced by the default; use YieldsToRequests.
+	YieldToRequests *bool `yaml:"yield_to_requests" default:"true"`
+	// Attributes is where the audio attributes of BGM candidates come from.
+	Attributes AttributesConfig `yaml:"attributes"`
+	// Arc is the curve BGM follows over the session, from the start to end_time.
+	// Candidates closest to the curve are queued first. Requires attributes.
+	Arc []ArcPointConfig `yaml:"arc" validate:"dive"`
+}
+
+// AttributesConfig represents the source of audio attributes (energy, tempo, valence).
+type AttributesConfig struct {
+	Source string `yaml:"source" validate:"omitempty,oneof=spotify file"` // "spotify": audio features API, "file": a local catalog file
+	Path   string `yaml:"path" validate:"required_if=Source file"`         // CSV or JSON file with energy, tempo and valence (source "file")
+}
+
+// ArcPointConfig is a point of the BGM arc. Targets left unset are not followed.
+type ArcPointConfig struct {
+	At      float64  `yaml:"at" validate:"gte=0,lte=1"` // Position in the session (0.0: start, 1.0: end_time)
+	Energy  *float64 `yaml:"energy" validate:"omitempty,gte=0,lte=1"`
+	Tempo   *float64 `yaml:"tempo" validate:"omitempty,gt=0"`
+	Valence *float64 `yaml:"valence" validate:"omitempty,gte=0,lte=1"`
+}
+
+// validateArc checks that the arc points are in order and have an attribute source.
+func (b BGMConfig) validateArc() error {
+	if len(b.Arc) == 0 {
+		return nil
+	}
+	if b.Attributes.Source == "" {
+		return errors.New("bgm.arc requires bgm.attributes.source")
+	}
+	for i, p := range b.Arc {
+		if i > 0 && p.At <= b.Arc[i-1].At {
+			return errors.New("bgm.arc points must be in increasing order of at")
+		}
+		if p.Energy == nil && p.Tempo == nil && p.Valence == nil {
+			return errors.Newf("bgm.arc point at %v has no energy, tempo or valence", p.At)
+		}
+	}
+	return nil
+}
+
+// YieldsToRequests reports whether user requests overtake queued BGM (default: true).
+func (c BGMConfig) YieldsToRequests() bool {
+	return c.YieldToRequests == nil || *c.YieldToRequests
 }
 
 // ProviderConfig represents a single BGM provider configuration.
@@ -83,6 +274,8 @@ type ProviderConfig struct {
 	Type        string         `yaml:"type" validate:"required"`
 	DisplayName string         `yaml:"display_name" validate:"required"`
 	Settings    map[string]any `yaml:"settings" validate:"required"`
+	// Weight is the relative chance of the provider being picked by the "weighted" strategy.
+	Weight float64 `yaml:"weight" default:"1" validate:"gte=0"`
 }
 
 // FilterConfig represents a filter's configuration.
@@ -104,6 +297,7 @@ type MessagesConfig struct {
 	TrackNotFound         string `yaml:"track_not_found"`
 	InvalidListener       string `yaml:"invalid_listener"`
 	DurationLimitExceeded string `yaml:"duration_limit_exceeded"`
+	RequestsPaused        string `yaml:"requests_paused"`
 }
 
 // SpotifyConfig represents Spotify API configuration.
@@ -114,6 +308,12 @@ type SpotifyConfig struct {
 	Market       string `yaml:"market" validate:"omitempty,len=2" default:"JP"`
 }
 
+// LibraryConfig represents the library of tracks played across sessions.
+// The library is shared by every room.
+type LibraryConfig struct {
+	Path string `yaml:"path"` // Library file (empty: no library)
+}
+
 // Load loads configuration from a YAML file.
 // Environment variables take precedence over file values for sensitive fields.
 func Load(path string) (*Config, error) {
@@ -134,6 +334,11 @@ func Load(path string) (*Config, error) {
 	if err := defaults.Set(&cfg); err != nil {
 		return nil, errors.Wrap(err, "failed to set defaults")
 	}
+	for i := range cfg.Rooms {
+		if err := cfg.Rooms[i].setDefaults(); err != nil {
+			return nil, errors.Wrapf(err, "failed to set defaults for room %s", cfg.Rooms[i].Name)
+		}
+	}
 
 	// Validate configuration
 	if err := cfg.Validate(); err != nil {
@@ -155,10 +360,10 @@ func (c *Config) overrideFromEnv() {
 		c.Spotify.RefreshToken = v
 	}
 	if v := os.Getenv("LASTFM_API_KEY"); v != "" {
-		for i := range c.BGM.Providers {
-			if c.BGM.Providers[i].Type == "lastfm" {
-				c.BGM.Providers[i].Settings["api_key"] = v
-				break
+		setLastFmAPIKey(&c.BGM, v)
+		for i := range c.Rooms {
+			if c.Rooms[i].BGM != nil {
+				setLastFmAPIKey(c.Rooms[i].BGM, v)
 			}
 		}
 	}
@@ -167,6 +372,16 @@ func (c *Config) overrideFromEnv() {
 	}
 }
 
+// setLastFmAPIKey sets the API key of the first Last.fm provider.
+func setLastFmAPIKey(bgm *BGMConfig, apiKey string) {
+	for i := range bgm.Providers {
+		if bgm.Providers[i].Type == "lastfm" {
+			bgm.Providers[i].Settings["api_key"] = apiKey
+			break
+		}
+	}
+}
+
 // GetMessage returns the message for the given code.
 func (c *Config) GetMessage(code string) string {
 	switch code {
@@ -190,6 +405,8 @@ func (c *Config) GetMessage(code string) string {
 		return c.Messages.DuplicateTrack
 	case "duration_limit_exceeded":
 		return c.Messages.DurationLimitExceeded
+	case "requests_paused":
+		return c.Messages.RequestsPaused
 	default:
 		return c.Messages.DefaultError
 	}
@@ -217,19 +434,173 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	// A Spotify account plays on one device at a time
+	if (c.Playback.Device.Enabled || c.Playback.Drift.Enabled) && c.HasRooms() {
+		return errors.New("playback.device and playback.drift cannot be enabled when rooms are configured")
+	}
+
+	// Validate rooms
+	names := make(map[string]bool, len(c.Rooms))
+	for _, room := range c.Rooms {
+		if names[room.Name] {
+			return errors.Newf("duplicate room name: %s", room.Name)
+		}
+		names[room.Name] = true
+
+		roomCfg, err := c.ForRoom(room.Name)
+		if err != nil {
+			return err
+		}
+		if err := roomCfg.validateTimeConsistency(); err != nil {
+			return errors.Wrapf(err, "room %s", room.Name)
+		}
+		if err := roomCfg.Playlists.Jingle.validate(); err != nil {
+			return errors.Wrapf(err, "room %s", room.Name)
+		}
+		if err := roomCfg.Session.validateSegments(); err != nil {
+			return errors.Wrapf(err, "room %s", room.Name)
+		}
+		if err := roomCfg.BGM.validateArc(); err != nil {
+			return errors.Wrapf(err, "room %s", room.Name)
+		}
+	}
+
+	if err := c.BGM.validateArc(); err != nil {
+		return err
+	}
+
+	if err := c.Session.validateSegments(); err != nil {
+		return err
+	}
+	return c.Playlists.Jingle.validate()
+}
+
+// validateSegments checks that segment times can be parsed and names are unique.
+func (s SessionConfig) validateSegments() error {
+	names := make(map[string]bool, len(s.Segments))
+	for _, seg := range s.Segments {
+		if names[seg.Name] {
+			return errors.Newf("duplicate segment name: %s", seg.Name)
+		}
+		names[seg.Name] = true
+
+		if _, err := seg.ParseAt(time.Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks that a configured jingle playlist is played at some point.
+func (j JingleConfig) validate() error {
+	if j.PlaylistURL != "" && j.EveryTracks == 0 && j.EveryMinutes == 0 && !j.AtPhaseBoundaries {
+		return errors.New("playlists.jingle requires every_tracks, every_minutes or at_phase_boundaries")
+	}
+	return nil
+}
+
+// HasRooms reports whether multiple rooms are configured.
+func (c *Config) HasRooms() bool {
+	return len(c.Rooms) > 0
+}
+
+// RoomNames returns the configured room names in order.
+func (c *Config) RoomNames() []string {
+	names := make([]string, len(c.Rooms))
+	for i, room := range c.Rooms {
+		names[i] = room.Name
+	}
+	return names
+}
+
+// ForRoom returns the effective configuration of a room: a copy of the
+// top-level configuration with the room's sections applied.
+// If no rooms are configured, the top-level configuration is the only room
+// and the empty name refers to it.
+func (c *Config) ForRoom(name string) (*Config, error) {
+	if !c.HasRooms() {
+		if name != "" {
+			return nil, errors.Newf("unknown room: %s (no rooms configured)", name)
+		}
+		return c, nil
+	}
+	if name == "" {
+		return nil, errors.New("room name is required when rooms are configured")
+	}
+
+	for _, room := range c.Rooms {
+		if room.Name != name {
+			continue
+		}
+		roomCfg := *c
+		roomCfg.Rooms = nil
+		if room.Session != nil {
+			roomCfg.Session = *room.Session
+		}
+		if room.Playlists != nil {
+			roomCfg.Playlists = *room.Playlists
+		}
+		if room.BGM != nil {
+			roomCfg.BGM = *room.BGM
+		}
+		if room.Filters != nil {
+			roomCfg.Filters = room.Filters
+		}
+		return &roomCfg, nil
+	}
+	return nil, errors.Newf("unknown room: %s", name)
+}
+
+// setDefaults sets defaults on the sections the room overrides.
+func (r *RoomConfig) setDefaults() error {
+	if r.Session != nil {
+		if err := defaults.Set(r.Session); err != nil {
+			return err
+		}
+	}
+	if r.Playlists != nil {
+		if err := defaults.Set(r.Playlists); err != nil {
+			return err
+		}
+	}
+	if r.BGM != nil {
+		if err := defaults.Set(r.BGM); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // validateTimeConsistency checks that end time is after start time and not in the past.
+// The session times are not used when sessions are started manually, recur, or when
+// rooms are configured (each room is checked separately), so they are not checked.
 func (c *Config) validateTimeConsistency() error {
+	if c.Session.Recurrence != nil {
+		if c.Session.StartTime != "" || c.Session.EndTime != "" {
+			return errors.New("start_time and end_time cannot be combined with recurrence")
+		}
+		if c.Session.ManualStart {
+			return errors.New("manual_start cannot be combined with recurrence")
+		}
+		return nil
+	}
+	if c.Session.ManualStart || c.HasRooms() {
+		return nil
+	}
+	return ValidateSessionTimes(c.Session.StartTime, c.Session.EndTime)
+}
+
+// ValidateSessionTimes checks that session times (RFC3339, empty if unset)
+// are valid, not in the past, and that start time is before end time.
+func ValidateSessionTimes(start, end string) error {
 	now := time.Now()
 
 	var startTime, endTime time.Time
 	var hasStart, hasEnd bool
 
 	// Check start_time if it's set
-	if c.Session.StartTime != "" {
-		st, err := time.Parse(time.RFC3339, c.Session.StartTime)
+	if start != "" {
+		st, err := time.Parse(time.RFC3339, start)
 		if err != nil {
 			return errors.Wrap(err, "failed to parse start_time")
 		}
@@ -238,13 +609,13 @@ func (c *Config) validateTimeConsistency() error {
 
 		// Check if start_time is in the past
 		if startTime.Before(now) {
-			return errors.Newf("start_time (%s) must be in the future (current time: %s)", c.Session.StartTime, now.Format(time.RFC3339))
+			return errors.Newf("start_time (%s) must be in the future (current time: %s)", start, now.Format(time.RFC3339))
 		}
 	}
 
 	// Check end_time if it's set
-	if c.Session.EndTime != "" {
-		et, err := time.Parse(time.RFC3339, c.Session.EndTime)
+	if end != "" {
+		et, err := time.Parse(time.RFC3339, end)
 		if err != nil {
 			return errors.Wrap(err, "failed to parse end_time")
 		}
@@ -253,14 +624,14 @@ func (c *Config) validateTimeConsistency() error {
 
 		// Check if end_time is in the past
 		if endTime.Before(now) {
-			return errors.Newf("end_time (%s) must be in the future (current time: %s)", c.Session.EndTime, now.Format(time.RFC3339))
+			return errors.Newf("end_time (%s) must be in the future (current time: %s)", end, now.Format(time.RFC3339))
 		}
 	}
 
 	// Check if start_time is before end_time
 	if hasStart && hasEnd {
 		if !startTime.Before(endTime) {
-			return errors.Newf("start_time (%s) must be before end_time (%s)", c.Session.StartTime, c.Session.EndTime)
+			return errors.Newf("start_time (%s) must be before end_time (%s)", start, end)
 		}
 	}
 
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -306,3 +309,356 @@ func TestConfig_validateTimeConsistency(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_validateTimeConsistency_ManualStart(t *testing.T) {
+	cfg := &Config{
+		Session: SessionConfig{
+			StartTime:   "2000-01-01T12:00:00Z",
+			EndTime:     "2000-01-01T11:00:00Z",
+			ManualStart: true,
+		},
+	}
+
+	assert.NoError(t, cfg.validateTimeConsistency(),
+		"session times should not be checked when sessions are started manually")
+}
+
+func TestConfig_ForRoom(t *testing.T) {
+	cfg := &Config{
+		Session: SessionConfig{Title: "Default", Keywords: []string{"default"}},
+		Playlists: PlaylistsConfig{
+			Opening: PlaylistEntryConfig{PlaylistURL: "spotify:playlist:opening", DisplayName: "Opening"},
+		},
+		BGM: BGMConfig{CandidateCount: 5},
+		Filters: map[string]FilterConfig{
+			"duplicate_track_filter": {Enabled: true},
+		},
+		Rooms: []RoomConfig{
+			{
+				Name:    "lounge",
+				Session: &SessionConfig{Title: "Lounge"},
+			},
+			{
+				Name: "party",
+				BGM:  &BGMConfig{CandidateCount: 10},
+				Filters: map[string]FilterConfig{
+					"duplicate_track_filter": {Enabled: false},
+				},
+			},
+		},
+	}
+
+	lounge, err := cfg.ForRoom("lounge")
+	require.NoError(t, err)
+	assert.Equal(t, "Lounge", lounge.Session.Title)
+	assert.Empty(t, lounge.Session.Keywords, "session section is replaced as a whole")
+	assert.Equal(t, "Opening", lounge.Playlists.Opening.DisplayName, "unset sections fall back to top-level")
+	assert.True(t, lounge.IsFilterEnabled("duplicate_track_filter"))
+	assert.Nil(t, lounge.Rooms)
+
+	party, err := cfg.ForRoom("party")
+	require.NoError(t, err)
+	assert.Equal(t, "Default", party.Session.Title)
+	assert.Equal(t, 10, party.BGM.CandidateCount)
+	assert.False(t, party.IsFilterEnabled("duplicate_track_filter"))
+
+	_, err = cfg.ForRoom("unknown")
+	assert.Error(t, err)
+
+	_, err = cfg.ForRoom("")
+	assert.Error(t, err, "room name is required when rooms are configured")
+
+	single := &Config{Session: SessionConfig{Title: "Single"}}
+	got, err := single.ForRoom("")
+	require.NoError(t, err)
+	assert.Same(t, single, got)
+}
+
+func TestConfig_validateTimeConsistency_Recurrence(t *testing.T) {
+	recurrence := &RecurrenceConfig{Cron: "0 12 * * 1-5", Duration: "1h"}
+
+	tests := []struct {
+		name    string
+		session SessionConfig
+		wantErr bool
+	}{
+		{
+			name:    "recurrence only",
+			session: SessionConfig{Recurrence: recurrence},
+			wantErr: false,
+		},
+		{
+			name:    "recurrence with end_time",
+			session: SessionConfig{Recurrence: recurrence, EndTime: "2099-01-01T13:00:00Z"},
+			wantErr: true,
+		},
+		{
+			name:    "recurrence with manual_start",
+			session: SessionConfig{Recurrence: recurrence, ManualStart: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Session: tt.session}
+			err := cfg.validateTimeConsistency()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// validConfig returns a minimal configuration that passes Validate.
+func validConfig() *Config {
+	return &Config{
+		Spotify: SpotifyConfig{ClientID: "id", ClientSecret: "secret", RefreshToken: "token"},
+		Admin:   AdminConfig{Token: "admin"},
+		BGM: BGMConfig{
+			Providers: []ProviderConfig{{Type: "playlist", DisplayName: "BGM", Settings: map[string]any{}}},
+		},
+	}
+}
+
+func TestConfig_Validate_PlaybackDevice(t *testing.T) {
+	cfg := validConfig()
+	cfg.Playback = PlaybackConfig{Device: DeviceConfig{Enabled: true}}
+	cfg.Rooms = []RoomConfig{{Name: "lounge"}}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "playback.device")
+
+	cfg.Playback = PlaybackConfig{Drift: DriftConfig{Enabled: true, Action: "alert"}}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Rooms = nil
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Playback.Drift.Action = "ignore"
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Jingle(t *testing.T) {
+	cfg := validConfig()
+	cfg.Playlists.Jingle = JingleConfig{PlaylistURL: "https://open.spotify.com/playlist/jingles"}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "playlists.jingle")
+
+	cfg.Playlists.Jingle.EveryTracks = 4
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Playlists.Jingle.EveryTracks = -1
+	assert.Error(t, cfg.Validate())
+
+	// A room's own playlists are validated too
+	cfg.Playlists.Jingle = JingleConfig{}
+	cfg.Rooms = []RoomConfig{{
+		Name:      "lounge",
+		Playlists: &PlaylistsConfig{Jingle: JingleConfig{PlaylistURL: "https://open.spotify.com/playlist/jingles"}},
+	}}
+	err = cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "room lounge")
+}
+
+func TestSegmentConfig_ParseAt(t *testing.T) {
+	loc := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2026, 3, 1, 20, 0, 0, 0, loc)
+
+	tests := []struct {
+		name    string
+		at      string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "time of day after the start",
+			at:   "21:30",
+			want: time.Date(2026, 3, 1, 21, 30, 0, 0, loc),
+		},
+		{
+			name: "time of day past midnight",
+			at:   "00:15",
+			want: time.Date(2026, 3, 2, 0, 15, 0, 0, loc),
+		},
+		{
+			name: "RFC3339",
+			at:   "2026-03-01T22:00:00+09:00",
+			want: time.Date(2026, 3, 1, 22, 0, 0, 0, loc),
+		},
+		{
+			name:    "invalid",
+			at:      "9pm",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SegmentConfig{Name: "set", At: tt.at}.ParseAt(start)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func TestConfig_Validate_Segments(t *testing.T) {
+	cfg := validConfig()
+	cfg.Session.Segments = []SegmentConfig{
+		{Name: "birthday", At: "21:00", Mode: "preempt"},
+		{Name: "slow dance", At: "22:30"},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Session.Segments[1].Name = "birthday"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate segment name")
+
+	cfg.Session.Segments[1] = SegmentConfig{Name: "slow dance", At: "late"}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Session.Segments[1] = SegmentConfig{Name: "slow dance", At: "22:30", Mode: "interrupt"}
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_EndingPolicy(t *testing.T) {
+	cfg := validConfig()
+
+	for _, policy := range []string{"", "drop", "play_out", "fit"} {
+		cfg.Session.EndingPolicy = policy
+		assert.NoError(t, cfg.Validate(), policy)
+	}
+
+	cfg.Session.EndingPolicy = "truncate"
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_LastCall(t *testing.T) {
+	cfg := validConfig()
+	cfg.Session.LastCall = LastCallConfig{
+		LeadMinutes: 10,
+		Filters: map[string]FilterConfig{
+			"duration_limit_filter": {Enabled: true, Settings: map[string]any{"max_minutes": 5}},
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Session.LastCall.LeadMinutes = -1
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_Seeds(t *testing.T) {
+	cfg := validConfig()
+	cfg.BGM.SeedTrackCount = 3
+	cfg.BGM.SeedUserWeight = 2
+	cfg.BGM.SeedDecay = 0.8
+	assert.NoError(t, cfg.Validate())
+
+	cfg.BGM.SeedDecay = 1.5
+	assert.Error(t, cfg.Validate())
+
+	cfg.BGM.SeedDecay = 0.8
+	cfg.BGM.SeedTrackCount = -1
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_BGMStrategy(t *testing.T) {
+	cfg := validConfig()
+	cfg.BGM.Providers[0].Weight = 2
+
+	for _, strategy := range []string{"", "all", "fallback", "weighted", "round_robin"} {
+		cfg.BGM.Strategy = strategy
+		assert.NoError(t, cfg.Validate(), strategy)
+	}
+
+	cfg.BGM.Strategy = "random"
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_BGMBuffer(t *testing.T) {
+	cfg := validConfig()
+	cfg.BGM.BufferTracks = 3
+	cfg.BGM.BufferSec = 600
+	assert.NoError(t, cfg.Validate())
+
+	cfg.BGM.BufferSec = -1
+	assert.Error(t, cfg.Validate())
+}
+
+func TestLoad_YieldToRequests(t *testing.T) {
+	base := `
+spotify: {client_id: id, client_secret: secret, refresh_token: token}
+admin: {token: admin}
+bgm:
+  providers:
+    - {type: playlist, display_name: BGM, settings: {}}
+`
+	tests := []struct {
+		name     string
+		yaml     string
+		expected bool
+	}{
+		{name: "default", yaml: base, expected: true},
+		{name: "enabled", yaml: base + "  yield_to_requests: true\n", expected: true},
+		{name: "disabled", yaml: base + "  yield_to_requests: false\n", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "server.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.yaml), 0644))
+
+			cfg, err := Load(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg.BGM.YieldsToRequests())
+		})
+	}
+}
+
+func TestConfig_Validate_BGMArc(t *testing.T) {
+	low, high := 0.2, 0.9
+	cfg := validConfig()
+	cfg.BGM.Attributes = AttributesConfig{Source: "file", Path: "attributes.csv"}
+	cfg.BGM.Arc = []ArcPointConfig{
+		{At: 0, Energy: &low},
+		{At: 0.6, Energy: &high},
+		{At: 1, Energy: &low},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	// The file source needs a path
+	cfg.BGM.Attributes.Path = ""
+	assert.Error(t, cfg.Validate())
+	cfg.BGM.Attributes.Path = "attributes.csv"
+
+	// Points out of order
+	cfg.BGM.Arc[1].At = 0
+	assert.Error(t, cfg.Validate())
+	cfg.BGM.Arc[1].At = 0.6
+
+	// A point without targets
+	cfg.BGM.Arc[1].Energy = nil
+	assert.Error(t, cfg.Validate())
+	cfg.BGM.Arc[1].Energy = &high
+
+	// Energy out of range
+	tooHigh := 1.5
+	cfg.BGM.Arc[2].Energy = &tooHigh
+	assert.Error(t, cfg.Validate())
+	cfg.BGM.Arc[2].Energy = &low
+
+	// An arc needs an attribute source
+	cfg.BGM.Attributes = AttributesConfig{}
+	assert.Error(t, cfg.Validate())
+}
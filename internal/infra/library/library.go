@@ -0,0 +1,276 @@
+// Package library provides the local library of tracks played in past sessions.
+package library
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	zlog "github.com/rs/zerolog/log"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// fileVersion is the version of the library file format.
+const fileVersion = 1
+
+// saveDelay is how long changes are collected before the library is written,
+// so that a burst of plays costs a single write.
+const saveDelay = 5 * time.Second
+
+// Entry is a track played in past sessions with how it was received.
+type Entry struct {
+	TrackID       string                      `json:"track_id"`
+	Name          string                      `json:"name"`
+	Artists       []string                    `json:"artists"`
+	Plays         map[track.RequesterType]int `json:"plays"`   // Times played, by requester type
+	Skips         int                         `json:"skips"`   // Times skipped
+	Replays       int                         `json:"replays"` // Times played again on request (e.g. going back to it)
+	FirstPlayedAt time.Time                   `json:"first_played_at"`
+	LastPlayedAt  time.Time                   `json:"last_played_at"`
+}
+
+// Requests returns the number of times the track was played as a user or admin request.
+func (e Entry) Requests() int {
+	return e.Plays[track.RequesterTypeUser] + e.Plays[track.RequesterTypeAdmin]
+}
+
+// TotalPlays returns the number of times the track was played, whoever queued it.
+func (e Entry) TotalPlays() int {
+	total := 0
+	for _, n := range e.Plays {
+		total += n
+	}
+	return total
+}
+
+// file is the on-disk format of the library.
+type file struct {
+	Version int      `json:"version"`
+	Tracks  []*Entry `json:"tracks"`
+}
+
+// Library is a persistent record of the tracks played across sessions.
+// It is safe for concurrent use. Changes are written to the file in the
+// background; Close writes any that are still pending.
+type Library struct {
+	mu      sync.RWMutex
+	path    string
+	entries map[string]*Entry // keyed by track ID
+	changed bool              // entries changed since the last save
+
+	dirty     chan struct{} // signals the saver that there are changes
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Open loads the library file at path. A missing file is an empty library.
+func Open(path string) (*Library, error) {
+	l := &Library{
+		path:    path,
+		entries: make(map[string]*Entry),
+		dirty:   make(chan struct{}, 1),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		go l.runSaver()
+		return l, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read library")
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrap(err, "failed to parse library")
+	}
+	if f.Version != fileVersion {
+		return nil, errors.Newf("unsupported library version: %d", f.Version)
+	}
+	for _, e := range f.Tracks {
+		if e.Plays == nil {
+			e.Plays = make(map[track.RequesterType]int)
+		}
+		l.entries[e.TrackID] = e
+	}
+	go l.runSaver()
+	return l, nil
+}
+
+// Record adds a finished play of a track. The library is saved in the
+// background shortly after. Jingles are not recorded.
+func (l *Library) Record(qt track.QueuedTrack, skipped bool, at time.Time) {
+	if qt.Requester.Type == track.RequesterTypeJingle || qt.Track.ID == "" {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e, ok := l.entries[qt.Track.ID]
+	if !ok {
+		e = &Entry{
+			TrackID:       qt.Track.ID,
+			Plays:         make(map[track.RequesterType]int),
+			FirstPlayedAt: at,
+		}
+		l.entries[qt.Track.ID] = e
+	}
+	e.Name = qt.Track.Name
+	e.Artists = qt.Track.Artists
+	if qt.Replay {
+		e.Replays++
+	} else {
+		e.Plays[qt.Requester.Type]++
+	}
+	if skipped {
+		e.Skips++
+	}
+	e.LastPlayedAt = at
+	l.changed = true
+
+	select {
+	case l.dirty <- struct{}{}:
+	default: // a save is already pending
+	}
+}
+
+// Close writes any pending changes and stops the background saver.
+func (l *Library) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closing)
+		<-l.done
+		l.closeErr = l.save()
+	})
+	return l.closeErr
+}
+
+// Entries returns a copy of every entry, most recently played first.
+func (l *Library) Entries() []Entry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	result := make([]Entry, 0, len(l.entries))
+	for _, e := range l.entries {
+		c := *e
+		c.Plays = make(map[track.RequesterType]int, len(e.Plays))
+		for k, v := range e.Plays {
+			c.Plays[k] = v
+		}
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].LastPlayedAt.Equal(result[j].LastPlayedAt) {
+			return result[i].LastPlayedAt.After(result[j].LastPlayedAt)
+		}
+		return result[i].TrackID < result[j].TrackID
+	})
+	return result
+}
+
+// Len returns the number of tracks in the library.
+func (l *Library) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.entries)
+}
+
+// runSaver saves the library saveDelay after the first of a run of changes,
+// until the library is closed.
+func (l *Library) runSaver() {
+	defer close(l.done)
+	for {
+		select {
+		case <-l.dirty:
+		case <-l.closing:
+			return
+		}
+
+		timer := time.NewTimer(saveDelay)
+		select {
+		case <-timer.C:
+		case <-l.closing:
+			timer.Stop()
+			return // Close saves the pending changes
+		}
+
+		if err := l.save(); err != nil {
+			zlog.Error().Msgf("failed to save library: path=%s error=%v", l.path, err)
+		}
+	}
+}
+
+// save writes the library if it changed since the last save. Only the
+// encoding holds the lock; the file is written without it. Saves are never
+// concurrent: they run on the saver goroutine, or in Close once it has exited.
+func (l *Library) save() error {
+	l.mu.Lock()
+	if !l.changed {
+		l.mu.Unlock()
+		return nil
+	}
+	data, err := l.encodeLocked()
+	if err == nil {
+		l.changed = false
+	}
+	l.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := l.writeFile(data); err != nil {
+		l.mu.Lock()
+		l.changed = true // retried on the next save
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// encodeLocked encodes the library in the file format.
+// Must be called with lock held.
+func (l *Library) encodeLocked() ([]byte, error) {
+	f := file{Version: fileVersion, Tracks: make([]*Entry, 0, len(l.entries))}
+	for _, e := range l.entries {
+		f.Tracks = append(f.Tracks, e)
+	}
+	sort.Slice(f.Tracks, func(i, j int) bool {
+		return f.Tracks[i].TrackID < f.Tracks[j].TrackID
+	})
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode library")
+	}
+	return data, nil
+}
+
+// writeFile writes data to a temporary file and renames it over the library
+// file, so that a crash never leaves a truncated library.
+func (l *Library) writeFile(data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to save library")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to save library")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to save library")
+	}
+	if err := os.Rename(tmp.Name(), l.path); err != nil {
+		return errors.Wrap(err, "failed to save library")
+	}
+	return nil
+}
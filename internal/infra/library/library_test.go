@@ -0,0 +1,106 @@
+package library
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+func queued(id string, requesterType track.RequesterType) track.QueuedTrack {
+	return track.QueuedTrack{
+		Track:     track.Track{ID: id, Name: "Track " + id, Artists: []string{"Artist"}},
+		Requester: track.Requester{Type: requesterType},
+	}
+}
+
+func TestOpen_MissingFile(t *testing.T) {
+	lib, err := Open(filepath.Join(t.TempDir(), "library.json"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, lib.Len())
+}
+
+func TestOpen_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "library.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 99, "tracks": []}`), 0644))
+
+	_, err := Open(path)
+	assert.Error(t, err)
+}
+
+func TestLibrary_Record(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "library.json")
+	lib, err := Open(path)
+	require.NoError(t, err)
+
+	day1 := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 7)
+
+	lib.Record(queued("a", track.RequesterTypeUser), false, day1)
+	lib.Record(queued("b", track.RequesterTypeBGM), true, day1)
+	lib.Record(queued("a", track.RequesterTypeAdmin), false, day2)
+	replay := queued("a", track.RequesterTypeUser)
+	replay.Replay = true
+	lib.Record(replay, false, day2)
+	// Jingles are not part of the library
+	lib.Record(queued("j", track.RequesterTypeJingle), false, day2)
+
+	// The library survives a restart
+	require.NoError(t, lib.Close())
+	lib, err = Open(path)
+	require.NoError(t, err)
+	entries := lib.Entries()
+	require.Len(t, entries, 2)
+
+	a := entries[0]
+	assert.Equal(t, "a", a.TrackID)
+	assert.Equal(t, 2, a.Requests())
+	assert.Equal(t, 2, a.TotalPlays())
+	assert.Equal(t, 1, a.Replays)
+	assert.Equal(t, 0, a.Skips)
+	assert.True(t, a.FirstPlayedAt.Equal(day1))
+	assert.True(t, a.LastPlayedAt.Equal(day2))
+
+	b := entries[1]
+	assert.Equal(t, "b", b.TrackID)
+	assert.Equal(t, 0, b.Requests())
+	assert.Equal(t, 1, b.Plays[track.RequesterTypeBGM])
+	assert.Equal(t, 1, b.Skips)
+}
+
+func TestLibrary_Record_SavesInBackground(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "library.json")
+	lib, err := Open(path)
+	require.NoError(t, err)
+
+	at := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	lib.Record(queued("a", track.RequesterTypeUser), false, at)
+	lib.Record(queued("b", track.RequesterTypeBGM), false, at)
+
+	// Plays are collected before being written
+	assert.NoFileExists(t, path)
+	assert.Equal(t, 2, lib.Len())
+
+	// Close writes the pending plays, and only once
+	require.NoError(t, lib.Close())
+	require.NoError(t, lib.Close())
+	lib, err = Open(path)
+	require.NoError(t, err)
+	defer lib.Close()
+	assert.Equal(t, 2, lib.Len())
+}
+
+func TestLibrary_Close_Unchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "library.json")
+	lib, err := Open(path)
+	require.NoError(t, err)
+
+	// Nothing is written without changes
+	require.NoError(t, lib.Close())
+	assert.NoFileExists(t, path)
+}
@@ -0,0 +1,102 @@
+package spotify
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/zmb3/spotify/v2"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// Artist represents a Spotify artist.
+type Artist struct {
+	ID         string
+	Name       string
+	Genres     []string
+	Popularity int
+}
+
+// GetRelatedArtists returns the artists Spotify considers similar to the given
+// artist, most similar first.
+func (c *Client) GetRelatedArtists(ctx context.Context, artistID string) ([]Artist, error) {
+	var result []spotify.FullArtist
+	err := c.retry(func() error {
+		a, err := c.client.GetRelatedArtists(ctx, spotify.ID(artistID))
+		if err != nil {
+			return err
+		}
+		result = a
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get related artists")
+	}
+
+	artists := make([]Artist, len(result))
+	for i, a := range result {
+		artists[i] = convertArtist(&a)
+	}
+	return artists, nil
+}
+
+// GetArtists returns the artists with the given IDs.
+// Spotify accepts up to 50 IDs per call.
+func (c *Client) GetArtists(ctx context.Context, artistIDs []string) ([]Artist, error) {
+	ids := make([]spotify.ID, len(artistIDs))
+	for i, id := range artistIDs {
+		ids[i] = spotify.ID(id)
+	}
+
+	var result []*spotify.FullArtist
+	err := c.retry(func() error {
+		a, err := c.client.GetArtists(ctx, ids...)
+		if err != nil {
+			return err
+		}
+		result = a
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get artists")
+	}
+
+	artists := make([]Artist, 0, len(result))
+	for _, a := range result {
+		if a != nil {
+			artists = append(artists, convertArtist(a))
+		}
+	}
+	return artists, nil
+}
+
+// GetArtistTopTracks returns the artist's most popular tracks in the client's market.
+func (c *Client) GetArtistTopTracks(ctx context.Context, artistID string) ([]track.Track, error) {
+	var result []spotify.FullTrack
+	err := c.retry(func() error {
+		t, err := c.client.GetArtistsTopTracks(ctx, spotify.ID(artistID), c.market)
+		if err != nil {
+			return err
+		}
+		result = t
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get artist top tracks")
+	}
+
+	tracks := make([]track.Track, 0, len(result))
+	for _, t := range result {
+		tracks = append(tracks, *c.convertTrack(&t))
+	}
+	return tracks, nil
+}
+
+func convertArtist(a *spotify.FullArtist) Artist {
+	return Artist{
+		ID:         string(a.ID),
+		Name:       a.Name,
+		Genres:     a.Genres,
+		Popularity: int(a.Popularity),
+	}
+}
@@ -0,0 +1,52 @@
+package spotify
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/zmb3/spotify/v2"
+
+	"github.com/osa030/19box/internal/domain/track"
+)
+
+// maxAudioFeatureIDs is the number of tracks Spotify accepts per audio features call.
+const maxAudioFeatureIDs = 100
+
+// GetAudioAttributes returns the audio attributes of tracks, keyed by track ID.
+// Tracks without audio features are left out.
+func (c *Client) GetAudioAttributes(ctx context.Context, trackIDs []string) (map[string]track.AudioAttributes, error) {
+	attributes := make(map[string]track.AudioAttributes, len(trackIDs))
+	for start := 0; start < len(trackIDs); start += maxAudioFeatureIDs {
+		end := min(start+maxAudioFeatureIDs, len(trackIDs))
+		ids := make([]spotify.ID, 0, end-start)
+		for _, id := range trackIDs[start:end] {
+			ids = append(ids, spotify.ID(id))
+		}
+
+		var result []*spotify.AudioFeatures
+		err := c.retry(func() error {
+			f, err := c.client.GetAudioFeatures(ctx, ids...)
+			if err != nil {
+				return err
+			}
+			result = f
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get audio features")
+		}
+
+		for _, f := range result {
+			if f == nil {
+				continue
+			}
+			tempo, valence := float64(f.Tempo), float64(f.Valence)
+			attributes[string(f.ID)] = track.AudioAttributes{
+				Energy:  float64(f.Energy),
+				Tempo:   &tempo,
+				Valence: &valence,
+			}
+		}
+	}
+	return attributes, nil
+}
@@ -18,6 +18,17 @@ import (
 	"github.com/osa030/19box/internal/domain/track"
 )
 
+// Scopes are the OAuth scopes the refresh token must be granted.
+// The playback scopes are used by device control mode.
+var Scopes = []string{
+	spotifyauth.ScopePlaylistModifyPublic,
+	spotifyauth.ScopePlaylistModifyPrivate,
+	spotifyauth.ScopePlaylistReadPrivate,
+	spotifyauth.ScopeUserReadPlaybackState,
+	spotifyauth.ScopeUserModifyPlaybackState,
+	spotifyauth.ScopeUserReadCurrentlyPlaying,
+}
+
 // Client is a Spotify API client.
 type Client struct {
 	client     *spotify.Client
@@ -44,11 +55,7 @@ func New(ctx context.Context, cfg Config) (*Client, error) {
 	auth := spotifyauth.New(
 		spotifyauth.WithClientID(cfg.ClientID),
 		spotifyauth.WithClientSecret(cfg.ClientSecret),
-		spotifyauth.WithScopes(
-			spotifyauth.ScopePlaylistModifyPublic,
-			spotifyauth.ScopePlaylistModifyPrivate,
-			spotifyauth.ScopePlaylistReadPrivate,
-		),
+		spotifyauth.WithScopes(Scopes...),
 	)
 
 	// Create token from refresh token
@@ -148,6 +155,45 @@ func (c *Client) Search(ctx context.Context, query string, searchType string, li
 	return tracks, nil
 }
 
+// SearchPlaylists searches for playlists on Spotify and returns their IDs.
+func (c *Client) SearchPlaylists(ctx context.Context, query string, limit int) ([]string, error) {
+	if query == "" {
+		return nil, errors.New("search query is required")
+	}
+
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	var result *spotify.SearchResult
+	err := c.retry(func() error {
+		r, err := c.client.Search(ctx, query, spotify.SearchTypePlaylist, spotify.Limit(limit))
+		if err != nil {
+			return err
+		}
+		result = r
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search playlists")
+	}
+
+	ids := make([]string, 0)
+	if result.Playlists == nil {
+		return ids, nil
+	}
+	for _, p := range result.Playlists.Playlists {
+		// Spotify may return empty entries for unavailable playlists
+		if p.ID != "" {
+			ids = append(ids, string(p.ID))
+		}
+	}
+	return ids, nil
+}
+
 // GetPlaylistTracks retrieves all tracks from a playlist.
 func (c *Client) GetPlaylistTracks(ctx context.Context, playlistURL string) ([]track.Track, error) {
 	playlistID := extractPlaylistID(playlistURL)
@@ -393,6 +439,46 @@ func (c *Client) RemoveTracksFromPlaylist(ctx context.Context, playlistID string
 	return nil
 }
 
+// RemovePlaylistTrackAt removes the occurrences of a track at the given positions,
+// keeping its other occurrences. Positions are 0-based indexes in the playlist.
+func (c *Client) RemovePlaylistTrackAt(ctx context.Context, playlistID, trackID string, positions []int) error {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	tracks := []spotify.TrackToRemove{spotify.NewTrackToRemove(extractTrackID(trackID), positions)}
+	err := c.retry(func() error {
+		_, err := c.client.RemoveTracksFromPlaylistOpt(ctx, spotify.ID(playlistID), tracks, "")
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to remove tracks from playlist")
+	}
+
+	return nil
+}
+
+// MovePlaylistTrack moves the track at position from to just before position insertBefore.
+// Positions are 0-based indexes in the playlist.
+func (c *Client) MovePlaylistTrack(ctx context.Context, playlistID string, from, insertBefore int) error {
+	if from < 0 || insertBefore < 0 {
+		return errors.New("invalid playlist position")
+	}
+
+	err := c.retry(func() error {
+		_, err := c.client.ReorderPlaylistTracks(ctx, spotify.ID(playlistID), spotify.PlaylistReorderOptions{
+			RangeStart:   spotify.Numeric(from),
+			InsertBefore: spotify.Numeric(insertBefore),
+		})
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to reorder playlist tracks")
+	}
+
+	return nil
+}
+
 // GetPlaylistURL returns the Spotify URL for a playlist.
 func (c *Client) GetPlaylistURL(playlistID string) string {
 	return fmt.Sprintf("https://open.spotify.com/playlist/%s", playlistID)
@@ -401,8 +487,10 @@ func (c *Client) GetPlaylistURL(playlistID string) string {
 // convertTrack converts a Spotify FullTrack to domain Track.
 func (c *Client) convertTrack(t *spotify.FullTrack) *track.Track {
 	artists := make([]string, len(t.Artists))
+	artistIDs := make([]string, len(t.Artists))
 	for i, a := range t.Artists {
 		artists[i] = a.Name
+		artistIDs[i] = string(a.ID)
 	}
 
 	var albumArt string
@@ -438,6 +526,7 @@ func (c *Client) convertTrack(t *spotify.FullTrack) *track.Track {
 		ID:          string(t.ID),
 		Name:        t.Name,
 		Artists:     artists,
+		ArtistIDs:   artistIDs,
 		Album:       t.Album.Name,
 		AlbumArtURL: albumArt,
 		Duration:    time.Duration(t.Duration) * time.Millisecond,
@@ -521,6 +610,11 @@ func extractPlaylistID(input string) string {
 	return input
 }
 
+// ParseTrackID returns the track ID of a Spotify track ID, URI or URL.
+func ParseTrackID(input string) string {
+	return extractTrackID(input)
+}
+
 // extractTrackID extracts the track ID from a Spotify track URL or URI.
 func extractTrackID(input string) string {
 	input = strings.TrimSpace(input)
@@ -0,0 +1,144 @@
+package spotify
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/zmb3/spotify/v2"
+)
+
+// ErrDeviceNotFound is returned when no matching Spotify Connect device is available.
+var ErrDeviceNotFound = errors.New("spotify device not found")
+
+// PlaybackState represents the playback state of the account's active device.
+type PlaybackState struct {
+	DeviceID string
+	TrackID  string // empty if nothing is loaded
+	Progress time.Duration
+	Playing  bool
+}
+
+// FindDevice returns the ID of the Spotify Connect device with the given name
+// (case-insensitive). If name is empty, the active device is returned.
+func (c *Client) FindDevice(ctx context.Context, name string) (string, error) {
+	var devices []spotify.PlayerDevice
+	err := c.retry(func() error {
+		d, err := c.client.PlayerDevices(ctx)
+		if err != nil {
+			return err
+		}
+		devices = d
+		return nil
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get devices")
+	}
+
+	for _, d := range devices {
+		if d.Restricted {
+			continue
+		}
+		if (name == "" && d.Active) || (name != "" && strings.EqualFold(d.Name, name)) {
+			return string(d.ID), nil
+		}
+	}
+	if name == "" {
+		return "", errors.Wrap(ErrDeviceNotFound, "no active device")
+	}
+	return "", errors.Wrapf(ErrDeviceNotFound, "device %q", name)
+}
+
+// TransferPlayback makes the device the account's active device without starting playback.
+func (c *Client) TransferPlayback(ctx context.Context, deviceID string) error {
+	err := c.retry(func() error {
+		return c.client.TransferPlayback(ctx, spotify.ID(deviceID), false)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to transfer playback")
+	}
+	return nil
+}
+
+// PlayTrack starts playing a single track on the device from the given position.
+func (c *Client) PlayTrack(ctx context.Context, deviceID, trackID string, position time.Duration) error {
+	id := spotify.ID(deviceID)
+	err := c.retry(func() error {
+		return c.client.PlayOpt(ctx, &spotify.PlayOptions{
+			DeviceID:   &id,
+			URIs:       []spotify.URI{spotify.URI("spotify:track:" + extractTrackID(trackID))},
+			PositionMs: spotify.Numeric(position.Milliseconds()),
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to start playback")
+	}
+	return nil
+}
+
+// PausePlayback pauses playback on the device.
+func (c *Client) PausePlayback(ctx context.Context, deviceID string) error {
+	id := spotify.ID(deviceID)
+	err := c.retry(func() error {
+		return c.client.PauseOpt(ctx, &spotify.PlayOptions{DeviceID: &id})
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to pause playback")
+	}
+	return nil
+}
+
+// ResumePlayback resumes paused playback on the device.
+func (c *Client) ResumePlayback(ctx context.Context, deviceID string) error {
+	id := spotify.ID(deviceID)
+	err := c.retry(func() error {
+		return c.client.PlayOpt(ctx, &spotify.PlayOptions{DeviceID: &id})
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to resume playback")
+	}
+	return nil
+}
+
+// SeekPlayback moves the playback position of the current track on the device.
+func (c *Client) SeekPlayback(ctx context.Context, deviceID string, position time.Duration) error {
+	id := spotify.ID(deviceID)
+	err := c.retry(func() error {
+		return c.client.SeekOpt(ctx, int(position.Milliseconds()), &spotify.PlayOptions{DeviceID: &id})
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to seek")
+	}
+	return nil
+}
+
+// GetPlaybackState returns the playback state of the account.
+// Returns nil if there is no active device.
+func (c *Client) GetPlaybackState(ctx context.Context) (*PlaybackState, error) {
+	var ps *spotify.PlayerState
+	err := c.retry(func() error {
+		s, err := c.client.PlayerState(ctx, spotify.Market(c.market))
+		if err != nil {
+			return err
+		}
+		ps = s
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get playback state")
+	}
+	if ps == nil || ps.Device.ID == "" {
+		return nil, nil
+	}
+
+	state := &PlaybackState{
+		DeviceID: string(ps.Device.ID),
+		Progress: time.Duration(ps.Progress) * time.Millisecond,
+		Playing:  ps.Playing,
+	}
+	if ps.Item != nil {
+		state.TrackID = string(ps.Item.ID)
+	}
+	return state, nil
+}